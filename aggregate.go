@@ -0,0 +1,186 @@
+package safemath
+
+import "math/bits"
+
+// Sum returns the sum of xs, or an error if any partial sum overflows. The
+// sum of an empty slice is 0.
+//
+// The error is an [*IndexError] holding the index of the element whose
+// addition first overflowed, wrapping the [Add] error for that step.
+func Sum[T Integer](xs []T) (T, error) {
+	var (
+		s        T
+		overflow bool
+	)
+
+	// Fast path: accumulate the wrapped sum and a sticky overflow flag, so the
+	// loop carries no error handling.
+	for _, x := range xs {
+		var o bool
+		s, o = OverflowingAdd(s, x)
+		overflow = overflow || o
+	}
+
+	if !overflow {
+		return s, nil
+	}
+
+	// Slow path: locate the first overflowing element.
+	s = 0
+	for i, x := range xs {
+		var err error
+		if s, err = Add(s, x); err != nil {
+			return 0, &IndexError{Index: i, Op: "Sum", Err: err}
+		}
+	}
+
+	// Unreachable: the slow path fails wherever the fast path overflowed.
+	return s, nil
+}
+
+// Product returns the product of xs, or an error if any partial product
+// overflows. The product of an empty slice is 1.
+//
+// The error is an [*IndexError] holding the index of the element whose
+// multiplication first overflowed, wrapping the [Mul] error for that step.
+func Product[T Integer](xs []T) (T, error) {
+	p := T(1)
+	for i, x := range xs {
+		c, err := Mul(p, x)
+		if err != nil {
+			return 0, &IndexError{Index: i, Op: "Product", Err: err}
+		}
+
+		// Once the product is zero, no later element can overflow it.
+		if c == 0 {
+			return 0, nil
+		}
+
+		p = c
+	}
+
+	return p, nil
+}
+
+// Min returns the smallest element of xs. The minimum of an empty slice is
+// undefined and reported as ErrDomain.
+func Min[T Integer](xs []T) (T, error) {
+	if len(xs) == 0 {
+		return 0, unaryOpError[T]("Min", ErrDomain, nil, xs)
+	}
+
+	m := xs[0]
+	for _, x := range xs[1:] {
+		if x < m {
+			m = x
+		}
+	}
+
+	return m, nil
+}
+
+// Max returns the largest element of xs. The maximum of an empty slice is
+// undefined and reported as ErrDomain.
+func Max[T Integer](xs []T) (T, error) {
+	if len(xs) == 0 {
+		return 0, unaryOpError[T]("Max", ErrDomain, nil, xs)
+	}
+
+	m := xs[0]
+	for _, x := range xs[1:] {
+		if x > m {
+			m = x
+		}
+	}
+
+	return m, nil
+}
+
+// SumExact returns the sum of xs, or ErrOverflow if the exact total does not
+// fit in T. Unlike [Sum], intermediate sums are accumulated in 128 bits, so
+// the order of the elements never causes a spurious overflow:
+//
+//	SumExact([]int64{math.MaxInt64, 1, -1}) // math.MaxInt64, nil
+//
+// On error, the operand of the returned [*OpError] is the exact total as an [Int128].
+func SumExact[T Integer](xs []T) (T, error) {
+	sum := sum128(xs)
+
+	v, ok := int128To[T](sum)
+	if !ok {
+		return 0, unaryOpError[T]("SumExact", ErrOverflow, direction(sum.Hi < 0), sum)
+	}
+
+	return v, nil
+}
+
+// sum128 returns the exact sum of xs. Each element adds less than 2^64 in
+// magnitude, so the sum cannot overflow for any slice length.
+func sum128[T Integer](xs []T) Int128 {
+	var (
+		hi    int64
+		lo    uint64
+		carry uint64
+	)
+
+	for _, x := range xs {
+		lo, carry = bits.Add64(lo, uint64(x), 0)
+		hi += int64(carry)
+		if x < 0 {
+			// Sign-extend negative elements into the high half.
+			hi--
+		}
+	}
+
+	return Int128{Hi: hi, Lo: lo}
+}
+
+// MustSum returns the sum of xs on success. Panics on error.
+func MustSum[T Integer](xs []T) T {
+	c, err := Sum(xs)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustProduct returns the product of xs on success. Panics on error.
+func MustProduct[T Integer](xs []T) T {
+	c, err := Product(xs)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustMin returns the smallest element of xs on success. Panics on error.
+func MustMin[T Integer](xs []T) T {
+	c, err := Min(xs)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustMax returns the largest element of xs on success. Panics on error.
+func MustMax[T Integer](xs []T) T {
+	c, err := Max(xs)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustSumExact returns the exact sum of xs on success. Panics on error.
+func MustSumExact[T Integer](xs []T) T {
+	c, err := SumExact(xs)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
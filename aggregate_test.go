@@ -0,0 +1,295 @@
+package safemath_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"go.dw1.io/safemath"
+)
+
+func TestSumProduct(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() (any, error)
+		want      any
+		wantError error
+		wantIndex int
+	}{
+		{
+			name: "Sum empty",
+			fn:   func() (any, error) { return safemath.Sum[int]([]int(nil)) },
+			want: 0,
+		},
+		{
+			name: "Sum uint32",
+			fn:   func() (any, error) { return safemath.Sum([]uint32{1, 2, math.MaxUint32 - 3}) },
+			want: uint32(math.MaxUint32),
+		},
+		{
+			name:      "Sum uint32 overflow",
+			fn:        func() (any, error) { return safemath.Sum([]uint32{1, 2, math.MaxUint32 - 2, 0}) },
+			want:      uint32(0),
+			wantError: safemath.ErrPositiveOverflow,
+			wantIndex: 2,
+		},
+		{
+			name:      "Sum int8 underflow",
+			fn:        func() (any, error) { return safemath.Sum([]int8{-100, 50, -50, -100}) },
+			want:      int8(0),
+			wantError: safemath.ErrNegativeOverflow,
+			wantIndex: 3,
+		},
+		{
+			// Wrapping twice brings the wrapped sum back in range, but the
+			// overflow is still reported.
+			name:      "Sum uint8 wraps back",
+			fn:        func() (any, error) { return safemath.Sum([]uint8{255, 1, 255, 1}) },
+			want:      uint8(0),
+			wantError: safemath.ErrOverflow,
+			wantIndex: 1,
+		},
+		{
+			name:      "Sum int64 intermediate overflow",
+			fn:        func() (any, error) { return safemath.Sum([]int64{math.MaxInt64, 1, -1}) },
+			want:      int64(0),
+			wantError: safemath.ErrPositiveOverflow,
+			wantIndex: 1,
+		},
+		{
+			name: "Product empty",
+			fn:   func() (any, error) { return safemath.Product[int]([]int{}) },
+			want: 1,
+		},
+		{
+			name: "Product int16",
+			fn:   func() (any, error) { return safemath.Product([]int16{-2, 128, 128}) },
+			want: int16(math.MinInt16),
+		},
+		{
+			name:      "Product int16 overflow",
+			fn:        func() (any, error) { return safemath.Product([]int16{2, 128, 128}) },
+			want:      int16(0),
+			wantError: safemath.ErrPositiveOverflow,
+			wantIndex: 2,
+		},
+		{
+			name:      "Product int8 underflow",
+			fn:        func() (any, error) { return safemath.Product([]int8{-1, 16, 16, 0}) },
+			want:      int8(0),
+			wantError: safemath.ErrNegativeOverflow,
+			wantIndex: 2,
+		},
+		{
+			name: "Product zero stops early",
+			fn:   func() (any, error) { return safemath.Product([]uint8{0, 255, 255}) },
+			want: uint8(0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("got error %v, want %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if tt.wantError == nil {
+				return
+			}
+
+			var indexErr *safemath.IndexError
+			if !errors.As(err, &indexErr) {
+				t.Fatalf("got error %T, want *safemath.IndexError", err)
+			}
+			if indexErr.Index != tt.wantIndex {
+				t.Errorf("got index %d, want %d", indexErr.Index, tt.wantIndex)
+			}
+		})
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() (any, error)
+		want      any
+		wantError error
+	}{
+		{
+			name: "Min int8",
+			fn:   func() (any, error) { return safemath.Min([]int8{3, math.MinInt8, -1, math.MaxInt8}) },
+			want: int8(math.MinInt8),
+		},
+		{
+			name: "Min single",
+			fn:   func() (any, error) { return safemath.Min([]uint64{math.MaxUint64}) },
+			want: uint64(math.MaxUint64),
+		},
+		{
+			name:      "Min empty",
+			fn:        func() (any, error) { return safemath.Min[int]([]int(nil)) },
+			want:      0,
+			wantError: safemath.ErrDomain,
+		},
+		{
+			name: "Max int8",
+			fn:   func() (any, error) { return safemath.Max([]int8{math.MinInt8, 3, math.MaxInt8, -1}) },
+			want: int8(math.MaxInt8),
+		},
+		{
+			name: "Max uint32",
+			fn:   func() (any, error) { return safemath.Max([]uint32{7, 0, math.MaxUint32, 9}) },
+			want: uint32(math.MaxUint32),
+		},
+		{
+			name:      "Max empty",
+			fn:        func() (any, error) { return safemath.Max([]uint16{}) },
+			want:      uint16(0),
+			wantError: safemath.ErrDomain,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("got error %v, want %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("got %v (%T), want %v (%T)", got, got, tt.want, tt.want)
+			}
+		})
+	}
+}
+
+func TestSumExact(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() (any, error)
+		want      any
+		wantError error
+	}{
+		{
+			name: "empty",
+			fn:   func() (any, error) { return safemath.SumExact[int64](nil) },
+			want: int64(0),
+		},
+		{
+			name: "int64 intermediate overflow",
+			fn:   func() (any, error) { return safemath.SumExact([]int64{math.MaxInt64, 1, -1}) },
+			want: int64(math.MaxInt64),
+		},
+		{
+			name: "int64 intermediate underflow",
+			fn: func() (any, error) {
+				return safemath.SumExact([]int64{math.MinInt64, math.MinInt64, math.MaxInt64, 1})
+			},
+			want: int64(math.MinInt64),
+		},
+		{
+			name:      "int64 overflow",
+			fn:        func() (any, error) { return safemath.SumExact([]int64{math.MaxInt64, 1}) },
+			want:      int64(0),
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name:      "int64 underflow",
+			fn:        func() (any, error) { return safemath.SumExact([]int64{math.MinInt64, -1, 0}) },
+			want:      int64(0),
+			wantError: safemath.ErrNegativeOverflow,
+		},
+		{
+			name: "uint64 intermediate overflow",
+			fn: func() (any, error) {
+				return safemath.SumExact([]uint64{math.MaxUint64, math.MaxUint64, 0})
+			},
+			want:      uint64(0),
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name: "uint8 max",
+			fn:   func() (any, error) { return safemath.SumExact([]uint8{200, 55}) },
+			want: uint8(255),
+		},
+		{
+			name: "int8 min",
+			fn:   func() (any, error) { return safemath.SumExact([]int8{-100, -100, 72}) },
+			want: int8(-128),
+		},
+		{
+			name:      "int8 below min",
+			fn:        func() (any, error) { return safemath.SumExact([]int8{-100, -100, 71}) },
+			want:      int8(0),
+			wantError: safemath.ErrNegativeOverflow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("got error %v, want %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSumExactError(t *testing.T) {
+	_, err := safemath.SumExact([]uint64{math.MaxUint64, math.MaxUint64})
+	if want := "SumExact[uint64](36893488147419103230): integer overflow"; err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+}
+
+func TestSumError(t *testing.T) {
+	_, err := safemath.Sum([]uint32{1, 2, math.MaxUint32 - 2})
+	if want := "index 2 (Sum): Add[uint32](3, 4294967293): integer overflow"; err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+
+	var opErr *safemath.OpError
+	if !errors.As(err, &opErr) || opErr.Op != "Add" {
+		t.Errorf("got error %v, want *OpError for Add", err)
+	}
+}
+
+func TestMustSumProduct(t *testing.T) {
+	if got := safemath.MustSum([]int{1, 2, 3}); got != 6 {
+		t.Errorf("MustSum = %d, want 6", got)
+	}
+	if got := safemath.MustSumExact([]int8{127, 1, -1}); got != 127 {
+		t.Errorf("MustSumExact = %d, want 127", got)
+	}
+	if got := safemath.MustProduct([]int{1, 2, 3}); got != 6 {
+		t.Errorf("MustProduct = %d, want 6", got)
+	}
+	if got := safemath.MustMin([]int{2, -1, 3}); got != -1 {
+		t.Errorf("MustMin = %d, want -1", got)
+	}
+	if got := safemath.MustMax([]int{2, -1, 3}); got != 3 {
+		t.Errorf("MustMax = %d, want 3", got)
+	}
+
+	for name, fn := range map[string]func(){
+		"MustSum":      func() { safemath.MustSum([]uint8{200, 100}) },
+		"MustSumExact": func() { safemath.MustSumExact([]int8{-128, -1}) },
+		"MustProduct":  func() { safemath.MustProduct([]uint8{16, 16}) },
+		"MustMin":      func() { safemath.MustMin([]int(nil)) },
+		"MustMax":      func() { safemath.MustMax([]int(nil)) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%s did not panic", name)
+				}
+			}()
+			fn()
+		})
+	}
+}
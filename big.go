@@ -0,0 +1,131 @@
+package safemath
+
+import "math/big"
+
+// FromBigInt safely converts x to the Integer type T, or returns
+// ErrTruncation if x cannot be represented. A nil x is rejected with
+// ErrInvalidType.
+func FromBigInt[T Integer](x *big.Int) (T, error) {
+	if x == nil {
+		return 0, unaryOpError[T]("FromBigInt", ErrInvalidType, nil, x)
+	}
+
+	v, ok := fromBigInt[T](x)
+	if !ok {
+		return 0, unaryOpError[T]("FromBigInt", ErrTruncation, direction(x.Sign() < 0), new(big.Int).Set(x))
+	}
+
+	return v, nil
+}
+
+// FromBigRat safely converts x to the Integer type T. Like [Convert], the
+// conversion must be exact: ErrTruncation is returned if x is not an integer
+// or cannot be represented in T. A nil x is rejected with ErrInvalidType.
+func FromBigRat[T Integer](x *big.Rat) (T, error) {
+	if x == nil {
+		return 0, unaryOpError[T]("FromBigRat", ErrInvalidType, nil, x)
+	}
+
+	if !x.IsInt() {
+		return 0, unaryOpError[T]("FromBigRat", ErrTruncation, nil, new(big.Rat).Set(x))
+	}
+
+	v, ok := fromBigInt[T](x.Num())
+	if !ok {
+		return 0, unaryOpError[T]("FromBigRat", ErrTruncation, direction(x.Sign() < 0), new(big.Rat).Set(x))
+	}
+
+	return v, nil
+}
+
+// FromBigFloat safely converts x to the Integer type T. Like [Convert], the
+// conversion must be exact: ErrTruncation is returned if x is not an integer
+// or cannot be represented in T, and ErrNotFinite if x is an infinity. A nil
+// x is rejected with ErrInvalidType.
+func FromBigFloat[T Integer](x *big.Float) (T, error) {
+	if x == nil {
+		return 0, unaryOpError[T]("FromBigFloat", ErrInvalidType, nil, x)
+	}
+
+	if x.IsInf() {
+		return 0, unaryOpError[T]("FromBigFloat", ErrNotFinite, nil, new(big.Float).Set(x))
+	}
+
+	if !x.IsInt() {
+		return 0, unaryOpError[T]("FromBigFloat", ErrTruncation, nil, new(big.Float).Set(x))
+	}
+
+	// Avoid materializing huge integers that cannot fit anyway.
+	if x.MantExp(nil) > int(bitSize[T]()) {
+		return 0, unaryOpError[T]("FromBigFloat", ErrTruncation, direction(x.Sign() < 0), new(big.Float).Set(x))
+	}
+
+	i, _ := x.Int(nil)
+	v, ok := fromBigInt[T](i)
+	if !ok {
+		return 0, unaryOpError[T]("FromBigFloat", ErrTruncation, direction(x.Sign() < 0), new(big.Float).Set(x))
+	}
+
+	return v, nil
+}
+
+// ToBigInt returns v as a newly allocated *big.Int.
+func ToBigInt[T Integer](v T) *big.Int {
+	if isSigned[T]() {
+		return big.NewInt(int64(v))
+	}
+
+	return new(big.Int).SetUint64(uint64(v))
+}
+
+// MustFromBigInt converts x to the Integer type T on success. Panics on
+// error.
+func MustFromBigInt[T Integer](x *big.Int) T {
+	c, err := FromBigInt[T](x)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustFromBigRat converts x to the Integer type T on success. Panics on
+// error.
+func MustFromBigRat[T Integer](x *big.Rat) T {
+	c, err := FromBigRat[T](x)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustFromBigFloat converts x to the Integer type T on success. Panics on
+// error.
+func MustFromBigFloat[T Integer](x *big.Float) T {
+	c, err := FromBigFloat[T](x)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// fromBigInt converts x to T, reporting whether it fits. As in [Convert], a
+// value fits if it survives the round trip with its sign intact.
+func fromBigInt[T Integer](x *big.Int) (T, bool) {
+	switch {
+	case x.IsInt64():
+		i := x.Int64()
+		v := T(i)
+
+		return v, int64(v) == i && (v < 0) == (i < 0)
+	case x.IsUint64():
+		u := x.Uint64()
+		v := T(u)
+
+		return v, uint64(v) == u && v >= 0
+	default:
+		return 0, false
+	}
+}
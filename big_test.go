@@ -0,0 +1,204 @@
+package safemath_test
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"go.dw1.io/safemath"
+)
+
+func bigInt(s string) *big.Int {
+	x, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		panic("invalid big.Int literal: " + s)
+	}
+
+	return x
+}
+
+func TestFromBig(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() (any, error)
+		want      any
+		wantError error
+	}{
+		{
+			name: "int32 max",
+			fn:   func() (any, error) { return safemath.FromBigInt[int32](big.NewInt(math.MaxInt32)) },
+			want: int32(math.MaxInt32),
+		},
+		{
+			name:      "int32 max+1",
+			fn:        func() (any, error) { return safemath.FromBigInt[int32](big.NewInt(math.MaxInt32 + 1)) },
+			want:      int32(0),
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name: "int64 min",
+			fn:   func() (any, error) { return safemath.FromBigInt[int64](big.NewInt(math.MinInt64)) },
+			want: int64(math.MinInt64),
+		},
+		{
+			name:      "int64 from uint64 max",
+			fn:        func() (any, error) { return safemath.FromBigInt[int64](new(big.Int).SetUint64(math.MaxUint64)) },
+			want:      int64(0),
+			wantError: safemath.ErrTruncation,
+		},
+		{
+			name: "uint64 max",
+			fn:   func() (any, error) { return safemath.FromBigInt[uint64](new(big.Int).SetUint64(math.MaxUint64)) },
+			want: uint64(math.MaxUint64),
+		},
+		{
+			name:      "uint64 max+1",
+			fn:        func() (any, error) { return safemath.FromBigInt[uint64](bigInt("0x10000000000000000")) },
+			want:      uint64(0),
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name:      "uint8 negative",
+			fn:        func() (any, error) { return safemath.FromBigInt[uint8](big.NewInt(-1)) },
+			want:      uint8(0),
+			wantError: safemath.ErrNegativeOverflow,
+		},
+		{
+			name:      "int8 huge negative",
+			fn:        func() (any, error) { return safemath.FromBigInt[int8](bigInt("-0x10000000000000000")) },
+			want:      int8(0),
+			wantError: safemath.ErrNegativeOverflow,
+		},
+		{
+			name:      "nil big.Int",
+			fn:        func() (any, error) { return safemath.FromBigInt[int]((*big.Int)(nil)) },
+			want:      0,
+			wantError: safemath.ErrInvalidType,
+		},
+		{
+			name: "rat integer",
+			fn:   func() (any, error) { return safemath.FromBigRat[int16](big.NewRat(-65536, 2)) },
+			want: int16(math.MinInt16),
+		},
+		{
+			name:      "rat fraction",
+			fn:        func() (any, error) { return safemath.FromBigRat[int16](big.NewRat(1, 2)) },
+			want:      int16(0),
+			wantError: safemath.ErrTruncation,
+		},
+		{
+			name:      "rat out of range",
+			fn:        func() (any, error) { return safemath.FromBigRat[uint16](big.NewRat(65536, 1)) },
+			want:      uint16(0),
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name: "float 2^63 as uint64",
+			fn: func() (any, error) {
+				return safemath.FromBigFloat[uint64](new(big.Float).SetMantExp(big.NewFloat(1), 63))
+			},
+			want: uint64(1) << 63,
+		},
+		{
+			name: "float -2^63 as int64",
+			fn: func() (any, error) {
+				return safemath.FromBigFloat[int64](new(big.Float).SetMantExp(big.NewFloat(-1), 63))
+			},
+			want: int64(math.MinInt64),
+		},
+		{
+			name: "float 2^63 as int64",
+			fn: func() (any, error) {
+				return safemath.FromBigFloat[int64](new(big.Float).SetMantExp(big.NewFloat(1), 63))
+			},
+			want:      int64(0),
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name: "float 2^1000 as int8",
+			fn: func() (any, error) {
+				return safemath.FromBigFloat[int8](new(big.Float).SetMantExp(big.NewFloat(-1), 1000))
+			},
+			want:      int8(0),
+			wantError: safemath.ErrNegativeOverflow,
+		},
+		{
+			name:      "float fraction",
+			fn:        func() (any, error) { return safemath.FromBigFloat[int](big.NewFloat(2.5)) },
+			want:      0,
+			wantError: safemath.ErrTruncation,
+		},
+		{
+			name:      "float infinity",
+			fn:        func() (any, error) { return safemath.FromBigFloat[int](new(big.Float).SetInf(true)) },
+			want:      0,
+			wantError: safemath.ErrNotFinite,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("got error %v, want %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromBigIntErrorOperand(t *testing.T) {
+	x := big.NewInt(300)
+	_, err := safemath.FromBigInt[int8](x)
+	x.SetInt64(0)
+
+	var opErr *safemath.OpError
+	if !errors.As(err, &opErr) {
+		t.Fatalf("FromBigInt error = %v; want *OpError", err)
+	}
+	if got := err.Error(); got != "FromBigInt[int8](300): integer type truncation (integer overflow)" {
+		t.Errorf("FromBigInt error = %q", got)
+	}
+}
+
+func TestToBigInt(t *testing.T) {
+	if got := safemath.ToBigInt(int64(math.MinInt64)); got.Cmp(big.NewInt(math.MinInt64)) != 0 {
+		t.Errorf("ToBigInt(MinInt64) = %v", got)
+	}
+	if got := safemath.ToBigInt(uint64(math.MaxUint64)); got.Cmp(new(big.Int).SetUint64(math.MaxUint64)) != 0 {
+		t.Errorf("ToBigInt(MaxUint64) = %v", got)
+	}
+	if got := safemath.ToBigInt(uintptr(7)); got.Int64() != 7 {
+		t.Errorf("ToBigInt(uintptr(7)) = %v", got)
+	}
+}
+
+func TestMustFromBig(t *testing.T) {
+	if got := safemath.MustFromBigInt[uint8](big.NewInt(255)); got != 255 {
+		t.Errorf("MustFromBigInt(255) = %d", got)
+	}
+	if got := safemath.MustFromBigRat[int8](big.NewRat(-254, 2)); got != -127 {
+		t.Errorf("MustFromBigRat(-254/2) = %d", got)
+	}
+	if got := safemath.MustFromBigFloat[int8](big.NewFloat(-128)); got != -128 {
+		t.Errorf("MustFromBigFloat(-128) = %d", got)
+	}
+
+	for name, fn := range map[string]func(){
+		"MustFromBigInt":   func() { safemath.MustFromBigInt[uint8](big.NewInt(256)) },
+		"MustFromBigRat":   func() { safemath.MustFromBigRat[uint8](big.NewRat(1, 3)) },
+		"MustFromBigFloat": func() { safemath.MustFromBigFloat[uint8](big.NewFloat(-1)) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%s did not panic", name)
+				}
+			}()
+			fn()
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package safemath
+
+// Bounds is an inclusive application-level range [Min, Max] for values of
+// type T, such as percentages (0 to 100) or port numbers (1 to 65535).
+//
+// Its methods first perform the operation with the usual overflow checks for
+// T, then return ErrOutOfRange if the result lies outside of the range. The
+// error additionally matches ErrAboveRange if the result is above Max, or
+// ErrBelowRange if it is below Min, but never ErrOverflow or its directions,
+// which are reserved for overflows of T. A Bounds with Min > Max contains no
+// values.
+type Bounds[T Integer] struct {
+	Min, Max T
+}
+
+// Contains reports whether v lies within b.
+func (b Bounds[T]) Contains(v T) bool {
+	return b.Min <= v && v <= b.Max
+}
+
+// Check returns v if it lies within b, or ErrOutOfRange otherwise.
+func (b Bounds[T]) Check(v T) (T, error) {
+	if !b.Contains(v) {
+		return 0, unaryOpError[T]("Check", ErrOutOfRange, rangeDirection(v < b.Min), v)
+	}
+
+	return v, nil
+}
+
+// Add returns the sum of x and y, or an error if it overflows T or lies
+// outside of b.
+func (b Bounds[T]) Add(x, y T) (T, error) {
+	return b.apply("Add", Add[T], x, y)
+}
+
+// Sub returns the difference of x and y, or an error if it overflows T or
+// lies outside of b.
+func (b Bounds[T]) Sub(x, y T) (T, error) {
+	return b.apply("Sub", Sub[T], x, y)
+}
+
+// Mul returns the product of x and y, or an error if it overflows T or lies
+// outside of b.
+func (b Bounds[T]) Mul(x, y T) (T, error) {
+	return b.apply("Mul", Mul[T], x, y)
+}
+
+// Div returns the quotient of x and y, or an error if the division fails or
+// the quotient lies outside of b.
+func (b Bounds[T]) Div(x, y T) (T, error) {
+	return b.apply("Div", Div[T], x, y)
+}
+
+// ConvertBounds converts v to the Integer type To like [Convert], then
+// checks that the result lies within b.
+func ConvertBounds[To, From Integer](b Bounds[To], v From) (To, error) {
+	c, err := Convert[To](v)
+	if err != nil {
+		return 0, err
+	}
+
+	if !b.Contains(c) {
+		return 0, unaryOpError[To]("ConvertBounds", ErrOutOfRange, rangeDirection(c < b.Min), v)
+	}
+
+	return c, nil
+}
+
+// apply performs the binary operation fn on x and y, then checks that the
+// result lies within b.
+func (b Bounds[T]) apply(op string, fn func(x, y T) (T, error), x, y T) (T, error) {
+	v, err := fn(x, y)
+	if err != nil {
+		return 0, err
+	}
+
+	if !b.Contains(v) {
+		return 0, binaryOpError(op, ErrOutOfRange, rangeDirection(v < b.Min), x, y)
+	}
+
+	return v, nil
+}
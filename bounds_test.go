@@ -0,0 +1,152 @@
+package safemath_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"go.dw1.io/safemath"
+)
+
+func TestBounds(t *testing.T) {
+	percent := safemath.Bounds[int8]{Min: 0, Max: 100}
+	port := safemath.Bounds[uint16]{Min: 1, Max: math.MaxUint16}
+
+	tests := []struct {
+		name      string
+		fn        func() (any, error)
+		want      any
+		wantError error
+	}{
+		{
+			name: "Add within",
+			fn:   func() (any, error) { return percent.Add(60, 40) },
+			want: int8(100),
+		},
+		{
+			name:      "Add above max",
+			fn:        func() (any, error) { return percent.Add(60, 41) },
+			want:      int8(0),
+			wantError: safemath.ErrAboveRange,
+		},
+		{
+			// The overflow of int8 is reported before the range check.
+			name:      "Add overflows type",
+			fn:        func() (any, error) { return percent.Add(100, 100) },
+			want:      int8(0),
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name:      "Sub below min",
+			fn:        func() (any, error) { return percent.Sub(10, 11) },
+			want:      int8(0),
+			wantError: safemath.ErrBelowRange,
+		},
+		{
+			name:      "Mul below min",
+			fn:        func() (any, error) { return percent.Mul(-1, 1) },
+			want:      int8(0),
+			wantError: safemath.ErrOutOfRange,
+		},
+		{
+			name:      "Div by zero",
+			fn:        func() (any, error) { return percent.Div(1, 0) },
+			want:      int8(0),
+			wantError: safemath.ErrDivisionByZero,
+		},
+		{
+			name: "Div within",
+			fn:   func() (any, error) { return percent.Div(-100, -2) },
+			want: int8(50),
+		},
+		{
+			name:      "port zero",
+			fn:        func() (any, error) { return port.Sub(80, 80) },
+			want:      uint16(0),
+			wantError: safemath.ErrOutOfRange,
+		},
+		{
+			name:      "port overflows type",
+			fn:        func() (any, error) { return port.Add(math.MaxUint16, 1) },
+			want:      uint16(0),
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "Check within",
+			fn:   func() (any, error) { return port.Check(443) },
+			want: uint16(443),
+		},
+		{
+			name:      "Check below min",
+			fn:        func() (any, error) { return port.Check(0) },
+			want:      uint16(0),
+			wantError: safemath.ErrBelowRange,
+		},
+		{
+			name:      "empty bounds",
+			fn:        func() (any, error) { return safemath.Bounds[int]{Min: 1, Max: 0}.Check(0) },
+			want:      0,
+			wantError: safemath.ErrOutOfRange,
+		},
+		{
+			name: "ConvertBounds within",
+			fn:   func() (any, error) { return safemath.ConvertBounds(port, int64(8080)) },
+			want: uint16(8080),
+		},
+		{
+			name:      "ConvertBounds out of range",
+			fn:        func() (any, error) { return safemath.ConvertBounds(port, int64(0)) },
+			want:      uint16(0),
+			wantError: safemath.ErrOutOfRange,
+		},
+		{
+			name:      "ConvertBounds truncation",
+			fn:        func() (any, error) { return safemath.ConvertBounds(port, int64(-1)) },
+			want:      uint16(0),
+			wantError: safemath.ErrTruncation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("got error %v, want %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBoundsError(t *testing.T) {
+	percent := safemath.Bounds[int8]{Min: 0, Max: 100}
+
+	_, err := percent.Add(60, 41)
+	if want := "Add[int8](60, 41): value out of range (above maximum)"; err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+
+	// Range errors are not type overflows.
+	for _, target := range []error{safemath.ErrOverflow, safemath.ErrPositiveOverflow, safemath.ErrNegativeOverflow} {
+		if errors.Is(err, target) {
+			t.Errorf("got error %v, want no match for %v", err, target)
+		}
+	}
+
+	// Type overflows are not range errors.
+	_, err = percent.Add(100, 100)
+	if errors.Is(err, safemath.ErrOutOfRange) {
+		t.Errorf("got error %v, want ErrOverflow only", err)
+	}
+}
+
+func TestBoundsContains(t *testing.T) {
+	b := safemath.Bounds[int]{Min: -5, Max: 5}
+	for v := -10; v <= 10; v++ {
+		if got, want := b.Contains(v), v >= -5 && v <= 5; got != want {
+			t.Errorf("Contains(%d) = %v; want %v", v, got, want)
+		}
+	}
+}
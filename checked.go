@@ -0,0 +1,99 @@
+package safemath
+
+// Checked is a sticky-error accumulator for evaluating long arithmetic
+// expressions without checking an error after every step.
+//
+// Each method returns a new Checked holding the result of the operation. Once
+// an operation fails, the error (wrapped in a [*StepError] identifying the
+// failing step) is recorded and every subsequent operation becomes a no-op, so
+// a whole formula can be written once and checked once with [Checked.Result]:
+//
+//	v, err := safemath.NewChecked(price).Mul(qty).Add(fee).Div(parts).Result()
+//
+// The zero value is a valid Checked holding 0.
+type Checked[T Integer] struct {
+	v    T
+	err  error
+	step int
+}
+
+// NewChecked returns a Checked holding v.
+func NewChecked[T Integer](v T) Checked[T] {
+	return Checked[T]{v: v}
+}
+
+// Add returns a Checked holding the sum of c and v.
+func (c Checked[T]) Add(v T) Checked[T] {
+	return c.apply("Add", Add[T], v)
+}
+
+// Sub returns a Checked holding the difference of c and v.
+func (c Checked[T]) Sub(v T) Checked[T] {
+	return c.apply("Sub", Sub[T], v)
+}
+
+// Mul returns a Checked holding the product of c and v.
+func (c Checked[T]) Mul(v T) Checked[T] {
+	return c.apply("Mul", Mul[T], v)
+}
+
+// Div returns a Checked holding the quotient of c and v.
+func (c Checked[T]) Div(v T) Checked[T] {
+	return c.apply("Div", Div[T], v)
+}
+
+// Err returns the error recorded by the first failing step, or nil.
+func (c Checked[T]) Err() error {
+	return c.err
+}
+
+// Result returns the accumulated value, or the error recorded by the first
+// failing step.
+func (c Checked[T]) Result() (T, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+
+	return c.v, nil
+}
+
+// ConvertChecked converts the value held by c to the Integer type To as the
+// next step of the computation. A previously recorded error is carried over.
+func ConvertChecked[To, From Integer](c Checked[From]) Checked[To] {
+	r := Checked[To]{err: c.err, step: c.step}
+	if c.err != nil {
+		return r
+	}
+
+	r.step++
+	v, err := Convert[To](c.v)
+	if err != nil {
+		r.err = &StepError{Step: r.step, Op: "Convert", Err: err}
+
+		return r
+	}
+
+	r.v = v
+
+	return r
+}
+
+// apply performs the binary operation fn on c and v, recording the first
+// error.
+func (c Checked[T]) apply(op string, fn func(a, b T) (T, error), v T) Checked[T] {
+	if c.err != nil {
+		return c
+	}
+
+	c.step++
+	r, err := fn(c.v, v)
+	if err != nil {
+		c.v, c.err = 0, &StepError{Step: c.step, Op: op, Err: err}
+
+		return c
+	}
+
+	c.v = r
+
+	return c
+}
@@ -0,0 +1,102 @@
+package safemath_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"go.dw1.io/safemath"
+)
+
+func TestChecked(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		got, err := safemath.NewChecked(10).Add(5).Sub(3).Mul(4).Div(6).Result()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != 8 {
+			t.Errorf("got %d, want 8", got)
+		}
+	})
+
+	t.Run("zero value", func(t *testing.T) {
+		var c safemath.Checked[uint8]
+		got, err := c.Add(1).Result()
+		if err != nil || got != 1 {
+			t.Errorf("got %d, %v; want 1, nil", got, err)
+		}
+	})
+
+	t.Run("first error sticks", func(t *testing.T) {
+		c := safemath.NewChecked[int8](100).Add(1).Mul(2).Div(0).Sub(1)
+		got, err := c.Result()
+		if got != 0 {
+			t.Errorf("got %d, want 0", got)
+		}
+		if !errors.Is(err, safemath.ErrOverflow) {
+			t.Fatalf("got error %v, want %v", err, safemath.ErrOverflow)
+		}
+		if c.Err() != err {
+			t.Errorf("Err() = %v, want %v", c.Err(), err)
+		}
+
+		var stepErr *safemath.StepError
+		if !errors.As(err, &stepErr) {
+			t.Fatalf("got error %T, want *safemath.StepError", err)
+		}
+		if stepErr.Step != 2 || stepErr.Op != "Mul" {
+			t.Errorf("got step %d (%s), want step 2 (Mul)", stepErr.Step, stepErr.Op)
+		}
+		if want := "step 2 (Mul): Mul[int8](101, 2): integer overflow"; err.Error() != want {
+			t.Errorf("got message %q, want %q", err.Error(), want)
+		}
+	})
+
+	t.Run("division by zero", func(t *testing.T) {
+		_, err := safemath.NewChecked(1).Div(0).Result()
+		if !errors.Is(err, safemath.ErrDivisionByZero) {
+			t.Errorf("got error %v, want %v", err, safemath.ErrDivisionByZero)
+		}
+	})
+
+	t.Run("immutable", func(t *testing.T) {
+		base := safemath.NewChecked[int64](math.MaxInt64)
+		_ = base.Add(1)
+		if got, err := base.Result(); err != nil || got != math.MaxInt64 {
+			t.Errorf("got %d, %v; want %d, nil", got, err, int64(math.MaxInt64))
+		}
+	})
+}
+
+func TestConvertChecked(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		c := safemath.NewChecked[int64](300).Sub(100)
+		got, err := safemath.ConvertChecked[uint8](c).Add(55).Result()
+		if err != nil || got != 255 {
+			t.Errorf("got %d, %v; want 255, nil", got, err)
+		}
+	})
+
+	t.Run("truncation", func(t *testing.T) {
+		c := safemath.NewChecked[int64](300).Add(1)
+		_, err := safemath.ConvertChecked[uint8](c).Add(1).Result()
+
+		var stepErr *safemath.StepError
+		if !errors.As(err, &stepErr) || !errors.Is(err, safemath.ErrTruncation) {
+			t.Fatalf("got error %v, want *StepError wrapping %v", err, safemath.ErrTruncation)
+		}
+		if stepErr.Step != 2 || stepErr.Op != "Convert" {
+			t.Errorf("got step %d (%s), want step 2 (Convert)", stepErr.Step, stepErr.Op)
+		}
+	})
+
+	t.Run("carries error", func(t *testing.T) {
+		c := safemath.NewChecked[uint64](0).Sub(1)
+		_, err := safemath.ConvertChecked[int8](c).Result()
+
+		var stepErr *safemath.StepError
+		if !errors.As(err, &stepErr) || stepErr.Step != 1 || stepErr.Op != "Sub" {
+			t.Errorf("got error %v, want step 1 (Sub)", err)
+		}
+	})
+}
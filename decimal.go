@@ -0,0 +1,381 @@
+package safemath
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+// MaxDecimalScale is the largest scale of a [Decimal], the number of decimal
+// digits that fit in an int64 coefficient.
+const MaxDecimalScale = 18
+
+// Decimal is a fixed-point decimal number, stored as an int64 coefficient
+// and a scale: its value is Coef() * 10^-Scale(). For example, 12.345 has
+// coefficient 12345 and scale 3. The zero value is 0 at scale 0.
+//
+// The scale of a Decimal is part of its value's representation, like the
+// number of decimal places of an amount of money, so 1.5 and 1.50 are
+// different Decimals that compare equal with [Decimal.Cmp].
+//
+// Failures are reported as an [*OpError] of Type "Decimal" whose Op names the
+// method (e.g., "Mul") and whose operands are the receiver and arguments. It
+// wraps ErrOverflow, with a direction, if a coefficient does not fit in an
+// int64, ErrInvalidScale for scales above MaxDecimalScale, or
+// ErrDivisionByZero.
+type Decimal struct {
+	coef  int64
+	scale uint8
+}
+
+// NewDecimal returns the Decimal coef * 10^-scale, or ErrInvalidScale if
+// scale exceeds MaxDecimalScale.
+func NewDecimal(coef int64, scale uint8) (Decimal, error) {
+	if scale > MaxDecimalScale {
+		return Decimal{}, decimalError("NewDecimal", ErrInvalidScale, nil, coef, scale)
+	}
+
+	return Decimal{coef: coef, scale: scale}, nil
+}
+
+// Coef returns the coefficient of x.
+func (x Decimal) Coef() int64 {
+	return x.coef
+}
+
+// Scale returns the scale of x, i.e. its number of fractional digits.
+func (x Decimal) Scale() uint8 {
+	return x.scale
+}
+
+// Sign returns -1 if x < 0, 0 if x == 0, and +1 if x > 0.
+func (x Decimal) Sign() int {
+	switch {
+	case x.coef < 0:
+		return -1
+	case x.coef > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Cmp compares x and y by value, regardless of their scales, and returns -1
+// if x < y, 0 if x == y, and +1 if x > y.
+func (x Decimal) Cmp(y Decimal) int {
+	a, b := x.align(y)
+
+	return a.Cmp(b)
+}
+
+// Rescale returns x with the given scale, rounding according to mode when
+// digits are dropped. It returns ErrOverflow if the rescaled coefficient
+// does not fit, and ErrInvalidScale if scale exceeds MaxDecimalScale.
+func (x Decimal) Rescale(scale uint8, mode RoundingMode) (Decimal, error) {
+	if scale > MaxDecimalScale {
+		return Decimal{}, decimalError("Rescale", ErrInvalidScale, nil, x, scale, mode)
+	}
+
+	num, den := Uint128{Lo: magnitude(x.coef)}, Uint128{Lo: 1}
+	if scale >= x.scale {
+		// Cannot overflow: |coef| < 2^64 and 10^18 < 2^64.
+		num, _ = num.Mul(pow10u128(uint(scale - x.scale)))
+	} else {
+		den = pow10u128(uint(x.scale - scale))
+	}
+
+	neg := x.coef < 0
+	c, ok := quo128(num, den, neg, mode)
+	if !ok {
+		return Decimal{}, decimalError("Rescale", ErrOverflow, direction(neg), x, scale, mode)
+	}
+
+	return Decimal{coef: c, scale: scale}, nil
+}
+
+// Add returns the sum of x and y at the larger of their scales, or
+// ErrOverflow if it does not fit.
+func (x Decimal) Add(y Decimal) (Decimal, error) {
+	a, b := x.align(y)
+
+	// Aligned coefficients are below 2^124, so their sum cannot overflow.
+	c, _ := a.Add(b)
+
+	return fromAligned("Add", c, x, y)
+}
+
+// Sub returns the difference of x and y at the larger of their scales, or
+// ErrOverflow if it does not fit.
+func (x Decimal) Sub(y Decimal) (Decimal, error) {
+	a, b := x.align(y)
+
+	// Aligned coefficients are below 2^124, so their difference cannot
+	// overflow.
+	c, _ := a.Sub(b)
+
+	return fromAligned("Sub", c, x, y)
+}
+
+// Mul returns the product of x and y at the given scale, rounded according
+// to mode. The exact product is computed at 128 bits, so Mul only fails if
+// the rounded result does not fit (ErrOverflow), or if scale exceeds
+// MaxDecimalScale (ErrInvalidScale).
+func (x Decimal) Mul(y Decimal, scale uint8, mode RoundingMode) (Decimal, error) {
+	if scale > MaxDecimalScale {
+		return Decimal{}, decimalError("Mul", ErrInvalidScale, nil, x, y, scale, mode)
+	}
+
+	var num Uint128
+	num.Hi, num.Lo = bits.Mul64(magnitude(x.coef), magnitude(y.coef))
+	neg := (x.coef < 0) != (y.coef < 0) && num != (Uint128{})
+
+	// The product has scale x.scale + y.scale; shift it to the target scale.
+	den, ok := Uint128{Lo: 1}, true
+	if exact := uint(x.scale) + uint(y.scale); uint(scale) >= exact {
+		num, ok = mulU128(num, pow10u128(uint(scale)-exact))
+	} else {
+		den = pow10u128(exact - uint(scale))
+	}
+
+	var c int64
+	if ok {
+		c, ok = quo128(num, den, neg, mode)
+	}
+	if !ok {
+		return Decimal{}, decimalError("Mul", ErrOverflow, direction(neg), x, y, scale, mode)
+	}
+
+	return Decimal{coef: c, scale: scale}, nil
+}
+
+// Div returns the quotient of x and y at the given scale, rounded according
+// to mode. It returns ErrDivisionByZero if y is zero, ErrOverflow if the
+// rounded result does not fit, and ErrInvalidScale if scale exceeds
+// MaxDecimalScale.
+func (x Decimal) Div(y Decimal, scale uint8, mode RoundingMode) (Decimal, error) {
+	if y.coef == 0 {
+		return Decimal{}, decimalError("Div", ErrDivisionByZero, nil, x, y, scale, mode)
+	}
+
+	if scale > MaxDecimalScale {
+		return Decimal{}, decimalError("Div", ErrInvalidScale, nil, x, y, scale, mode)
+	}
+
+	// x/y at the target scale is x.coef * 10^e / y.coef, where
+	// e = scale + y.scale - x.scale.
+	num, den, ok := Uint128{Lo: magnitude(x.coef)}, Uint128{Lo: magnitude(y.coef)}, true
+	if up, down := uint(scale)+uint(y.scale), uint(x.scale); up >= down {
+		num, ok = mulU128(num, pow10u128(up-down))
+	} else {
+		// Cannot overflow: |y.coef| < 2^64 and 10^18 < 2^64.
+		den, _ = den.Mul(pow10u128(down - up))
+	}
+
+	neg := (x.coef < 0) != (y.coef < 0) && x.coef != 0
+
+	var c int64
+	if ok {
+		c, ok = quo128(num, den, neg, mode)
+	}
+	if !ok {
+		return Decimal{}, decimalError("Div", ErrOverflow, direction(neg), x, y, scale, mode)
+	}
+
+	return Decimal{coef: c, scale: scale}, nil
+}
+
+// Neg returns the negation of x, or ErrOverflow if its coefficient is
+// math.MinInt64.
+func (x Decimal) Neg() (Decimal, error) {
+	if x.coef == -1<<63 {
+		return Decimal{}, decimalError("Neg", ErrOverflow, ErrPositiveOverflow, x)
+	}
+
+	return Decimal{coef: -x.coef, scale: x.scale}, nil
+}
+
+// String returns the decimal representation of x with exactly Scale()
+// fractional digits, e.g. "-0.050" for coefficient -50 at scale 3.
+func (x Decimal) String() string {
+	digits := strconv.FormatUint(magnitude(x.coef), 10)
+
+	buf := make([]byte, 0, len(digits)+int(x.scale)+2)
+	if x.coef < 0 {
+		buf = append(buf, '-')
+	}
+
+	if x.scale == 0 {
+		return string(append(buf, digits...))
+	}
+
+	n := len(digits) - int(x.scale)
+	if n > 0 {
+		buf = append(buf, digits[:n]...)
+		buf = append(buf, '.')
+
+		return string(append(buf, digits[n:]...))
+	}
+
+	// Pad with leading zeros, e.g. "0.005" for coefficient 5 at scale 3.
+	buf = append(buf, '0', '.')
+	for ; n < 0; n++ {
+		buf = append(buf, '0')
+	}
+
+	return string(append(buf, digits...))
+}
+
+// ParseDecimal parses s as a base 10 decimal number such as "12.345",
+// "-0.5" or "+7.", whose scale is its number of fractional digits.
+//
+// It returns ErrSyntax if s is malformed, ErrOverflow if the coefficient
+// does not fit in an int64, and ErrTruncation if s has more than
+// MaxDecimalScale significant fractional digits. All errors are reported as
+// an [*OpError] carrying s.
+func ParseDecimal(s string) (Decimal, error) {
+	digits, neg := s, false
+	if len(digits) > 0 && (digits[0] == '+' || digits[0] == '-') {
+		digits, neg = digits[1:], digits[0] == '-'
+	}
+
+	var (
+		m        uint64
+		scale    uint
+		n        int
+		seenDot  bool
+		overflow bool
+		inexact  bool
+	)
+
+	for i := 0; i < len(digits); i++ {
+		c := digits[i]
+		if c == '.' && !seenDot {
+			seenDot = true
+			continue
+		}
+
+		d := c - '0'
+		if d > 9 {
+			return Decimal{}, decimalError("ParseDecimal", ErrSyntax, nil, s)
+		}
+		n++
+
+		// Keep scanning after an error so syntax errors take precedence.
+		if seenDot {
+			if scale == MaxDecimalScale {
+				// Trailing zeros beyond the largest scale are harmless.
+				inexact = inexact || d != 0
+				continue
+			}
+			scale++
+		}
+
+		hi, lo := bits.Mul64(m, 10)
+		lo, carry := bits.Add64(lo, uint64(d), 0)
+		overflow = overflow || hi != 0 || carry != 0
+		m = lo
+	}
+
+	switch {
+	case n == 0:
+		return Decimal{}, decimalError("ParseDecimal", ErrSyntax, nil, s)
+	case inexact:
+		return Decimal{}, decimalError("ParseDecimal", ErrTruncation, nil, s)
+	}
+
+	c, ok := quo128(Uint128{Lo: m}, Uint128{Lo: 1}, neg, RoundTruncate)
+	if overflow || !ok {
+		return Decimal{}, decimalError("ParseDecimal", ErrOverflow, direction(neg), s)
+	}
+
+	return Decimal{coef: c, scale: uint8(scale)}, nil
+}
+
+// decimalError returns an *OpError for the Decimal operation op.
+func decimalError(op string, err, dir error, operands ...any) error {
+	return &OpError{
+		Op:        op,
+		Type:      "Decimal",
+		Operands:  operands,
+		Err:       err,
+		Direction: dir,
+	}
+}
+
+// align returns the coefficients of x and y at the larger of their scales.
+func (x Decimal) align(y Decimal) (a, b Int128) {
+	a, b = Int128From(x.coef), Int128From(y.coef)
+
+	// Cannot overflow: |coef| < 2^64 and 10^18 < 2^64.
+	switch {
+	case x.scale < y.scale:
+		a, _ = a.Mul(Int128{Lo: pow10tab[y.scale-x.scale]})
+	case x.scale > y.scale:
+		b, _ = b.Mul(Int128{Lo: pow10tab[x.scale-y.scale]})
+	}
+
+	return a, b
+}
+
+// fromAligned returns the Decimal with coefficient c at the larger of the
+// scales of x and y, or an error for op if c does not fit.
+func fromAligned(op string, c Int128, x, y Decimal) (Decimal, error) {
+	v, ok := int128To[int64](c)
+	if !ok {
+		return Decimal{}, decimalError(op, ErrOverflow, direction(c.Hi < 0), x, y)
+	}
+
+	scale := x.scale
+	if y.scale > scale {
+		scale = y.scale
+	}
+
+	return Decimal{coef: v, scale: scale}, nil
+}
+
+// quo128 returns the quotient of the magnitudes num and den, negated if neg
+// is true and rounded according to mode, and whether it fits in an int64.
+func quo128(num, den Uint128, neg bool, mode RoundingMode) (int64, bool) {
+	q, r := num.quoRem(den)
+
+	// r < den, so den - r cannot underflow.
+	rest, _ := den.Sub(r)
+	if roundAwayCmp(mode, r != (Uint128{}), r.Cmp(rest), neg, q.Lo&1 == 1) {
+		// Rounding only happens for inexact quotients, so den > 1 and q
+		// cannot be the largest Uint128.
+		q, _ = q.Add(Uint128{Lo: 1})
+	}
+
+	limit := uint64(1<<63 - 1)
+	if neg {
+		limit++
+	}
+
+	if q.Hi != 0 || q.Lo > limit {
+		return 0, false
+	}
+
+	c := int64(q.Lo)
+	if neg {
+		c = -c
+	}
+
+	return c, true
+}
+
+// mulU128 returns x*y, and whether the product fits in 128 bits.
+func mulU128(x, y Uint128) (Uint128, bool) {
+	p, err := x.Mul(y)
+
+	return p, err == nil
+}
+
+// pow10u128 returns 10^n as a Uint128, for n < 39.
+func pow10u128(n uint) Uint128 {
+	if n < uint(len(pow10tab)) {
+		return Uint128{Lo: pow10tab[n]}
+	}
+
+	p, _ := Uint128{Lo: pow10tab[19]}.Mul(Uint128{Lo: pow10tab[n-19]})
+
+	return p
+}
@@ -0,0 +1,421 @@
+package safemath_test
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+
+	"go.dw1.io/safemath"
+)
+
+func dec(coef int64, scale uint8) safemath.Decimal {
+	d, err := safemath.NewDecimal(coef, scale)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() (safemath.Decimal, error)
+		want      safemath.Decimal
+		wantError error
+	}{
+		{
+			name:      "NewDecimal scale too large",
+			fn:        func() (safemath.Decimal, error) { return safemath.NewDecimal(1, 19) },
+			wantError: safemath.ErrInvalidScale,
+		},
+		{
+			name: "Add aligns scales",
+			fn:   func() (safemath.Decimal, error) { return dec(15, 1).Add(dec(25, 2)) },
+			want: dec(175, 2),
+		},
+		{
+			name: "Add mixed signs",
+			fn:   func() (safemath.Decimal, error) { return dec(100, 2).Add(dec(-25, 1)) },
+			want: dec(-150, 2),
+		},
+		{
+			name:      "Add overflow",
+			fn:        func() (safemath.Decimal, error) { return dec(math.MaxInt64, 0).Add(dec(1, 0)) },
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name:      "Add overflow when aligning",
+			fn:        func() (safemath.Decimal, error) { return dec(math.MaxInt64, 0).Add(dec(-1, 1)) },
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name: "Add aligned to MinInt64",
+			fn: func() (safemath.Decimal, error) {
+				return dec(math.MinInt64/10, 17).Add(dec(-8, 18))
+			},
+			want: dec(math.MinInt64, 18),
+		},
+		{
+			name: "Sub",
+			fn:   func() (safemath.Decimal, error) { return dec(1, 1).Sub(dec(25, 2)) },
+			want: dec(-15, 2),
+		},
+		{
+			name:      "Sub overflow",
+			fn:        func() (safemath.Decimal, error) { return dec(math.MinInt64, 3).Sub(dec(1, 3)) },
+			wantError: safemath.ErrNegativeOverflow,
+		},
+		{
+			name: "Mul exact",
+			fn: func() (safemath.Decimal, error) {
+				return dec(15, 1).Mul(dec(15, 1), 2, safemath.RoundTruncate)
+			},
+			want: dec(225, 2),
+		},
+		{
+			name: "Mul half even",
+			fn: func() (safemath.Decimal, error) {
+				return dec(15, 1).Mul(dec(15, 1), 1, safemath.RoundHalfEven)
+			},
+			want: dec(22, 1),
+		},
+		{
+			name: "Mul half away from zero",
+			fn: func() (safemath.Decimal, error) {
+				return dec(15, 1).Mul(dec(15, 1), 1, safemath.RoundHalfAwayFromZero)
+			},
+			want: dec(23, 1),
+		},
+		{
+			name: "Mul floor negative",
+			fn: func() (safemath.Decimal, error) {
+				return dec(-15, 1).Mul(dec(15, 1), 1, safemath.RoundFloor)
+			},
+			want: dec(-23, 1),
+		},
+		{
+			name: "Mul ceil negative",
+			fn: func() (safemath.Decimal, error) {
+				return dec(-15, 1).Mul(dec(15, 1), 1, safemath.RoundCeil)
+			},
+			want: dec(-22, 1),
+		},
+		{
+			name: "Mul 128-bit intermediate",
+			fn: func() (safemath.Decimal, error) {
+				return dec(3e18, 18).Mul(dec(3e18, 18), 18, safemath.RoundTruncate)
+			},
+			want: dec(9e18, 18),
+		},
+		{
+			name: "Mul MinInt64",
+			fn: func() (safemath.Decimal, error) {
+				return dec(math.MinInt64, 0).Mul(dec(1, 0), 0, safemath.RoundTruncate)
+			},
+			want: dec(math.MinInt64, 0),
+		},
+		{
+			name: "Mul MinInt64 by -1",
+			fn: func() (safemath.Decimal, error) {
+				return dec(math.MinInt64, 0).Mul(dec(-1, 0), 0, safemath.RoundTruncate)
+			},
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name: "Mul overflow when scaling up",
+			fn: func() (safemath.Decimal, error) {
+				return dec(10, 0).Mul(dec(1, 0), 18, safemath.RoundTruncate)
+			},
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name: "Mul 128-bit overflow",
+			fn: func() (safemath.Decimal, error) {
+				return dec(math.MaxInt64, 0).Mul(dec(math.MaxInt64, 0), 18, safemath.RoundTruncate)
+			},
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name: "Mul scale too large",
+			fn: func() (safemath.Decimal, error) {
+				return dec(1, 0).Mul(dec(1, 0), 19, safemath.RoundTruncate)
+			},
+			wantError: safemath.ErrInvalidScale,
+		},
+		{
+			name: "Div repeating",
+			fn: func() (safemath.Decimal, error) {
+				return dec(1, 0).Div(dec(3, 0), 4, safemath.RoundHalfEven)
+			},
+			want: dec(3333, 4),
+		},
+		{
+			name: "Div half even",
+			fn: func() (safemath.Decimal, error) {
+				return dec(2, 0).Div(dec(3, 0), 4, safemath.RoundHalfEven)
+			},
+			want: dec(6667, 4),
+		},
+		{
+			name: "Div truncate",
+			fn: func() (safemath.Decimal, error) {
+				return dec(2, 0).Div(dec(3, 0), 4, safemath.RoundTruncate)
+			},
+			want: dec(6666, 4),
+		},
+		{
+			name: "Div floor negative",
+			fn: func() (safemath.Decimal, error) {
+				return dec(-2, 0).Div(dec(3, 0), 4, safemath.RoundFloor)
+			},
+			want: dec(-6667, 4),
+		},
+		{
+			name: "Div ceil negative",
+			fn: func() (safemath.Decimal, error) {
+				return dec(2, 0).Div(dec(-3, 0), 4, safemath.RoundCeil)
+			},
+			want: dec(-6666, 4),
+		},
+		{
+			name: "Div to smaller scale",
+			fn: func() (safemath.Decimal, error) {
+				return dec(100, 2).Div(dec(5, 1), 0, safemath.RoundTruncate)
+			},
+			want: dec(2, 0),
+		},
+		{
+			name: "Div zero dividend",
+			fn: func() (safemath.Decimal, error) {
+				return dec(0, 3).Div(dec(-7, 0), 2, safemath.RoundFloor)
+			},
+			want: dec(0, 2),
+		},
+		{
+			name: "Div by zero",
+			fn: func() (safemath.Decimal, error) {
+				return dec(1, 0).Div(dec(0, 5), 0, safemath.RoundTruncate)
+			},
+			wantError: safemath.ErrDivisionByZero,
+		},
+		{
+			name: "Div overflow",
+			fn: func() (safemath.Decimal, error) {
+				return dec(math.MaxInt64, 0).Div(dec(1, 1), 0, safemath.RoundTruncate)
+			},
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name: "Div overflow beyond 10^19",
+			fn: func() (safemath.Decimal, error) {
+				return dec(1, 0).Div(dec(1, 18), 18, safemath.RoundTruncate)
+			},
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name: "Div scale too large",
+			fn: func() (safemath.Decimal, error) {
+				return dec(1, 0).Div(dec(1, 0), 19, safemath.RoundTruncate)
+			},
+			wantError: safemath.ErrInvalidScale,
+		},
+		{
+			name: "Rescale up",
+			fn:   func() (safemath.Decimal, error) { return dec(15, 1).Rescale(3, safemath.RoundTruncate) },
+			want: dec(1500, 3),
+		},
+		{
+			name: "Rescale half even",
+			fn:   func() (safemath.Decimal, error) { return dec(125, 2).Rescale(1, safemath.RoundHalfEven) },
+			want: dec(12, 1),
+		},
+		{
+			name: "Rescale half away from zero",
+			fn:   func() (safemath.Decimal, error) { return dec(125, 2).Rescale(1, safemath.RoundHalfAwayFromZero) },
+			want: dec(13, 1),
+		},
+		{
+			name: "Rescale floor negative",
+			fn:   func() (safemath.Decimal, error) { return dec(-125, 2).Rescale(1, safemath.RoundFloor) },
+			want: dec(-13, 1),
+		},
+		{
+			name:      "Rescale overflow",
+			fn:        func() (safemath.Decimal, error) { return dec(math.MaxInt64, 0).Rescale(1, safemath.RoundTruncate) },
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name:      "Rescale scale too large",
+			fn:        func() (safemath.Decimal, error) { return dec(1, 0).Rescale(19, safemath.RoundTruncate) },
+			wantError: safemath.ErrInvalidScale,
+		},
+		{
+			name: "Neg",
+			fn:   func() (safemath.Decimal, error) { return dec(15, 1).Neg() },
+			want: dec(-15, 1),
+		},
+		{
+			name:      "Neg MinInt64",
+			fn:        func() (safemath.Decimal, error) { return dec(math.MinInt64, 2).Neg() },
+			wantError: safemath.ErrPositiveOverflow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("got error %v, want %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimalError(t *testing.T) {
+	_, err := dec(15, 1).Mul(dec(math.MaxInt64, 0), 0, safemath.RoundTruncate)
+	if want := "Mul[Decimal](1.5, 9223372036854775807, 0, RoundTruncate): integer overflow"; err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+
+	var opErr *safemath.OpError
+	if !errors.As(err, &opErr) || opErr.Op != "Mul" || opErr.Operands[0] != dec(15, 1) {
+		t.Errorf("got error %#v, want *OpError for Mul", err)
+	}
+
+	_, err = dec(-15, 1).Mul(dec(math.MaxInt64, 0), 0, safemath.RoundTruncate)
+	if !errors.Is(err, safemath.ErrNegativeOverflow) || !errors.Is(err, safemath.ErrOverflow) {
+		t.Errorf("got error %v, want ErrNegativeOverflow", err)
+	}
+
+	_, err = safemath.ParseDecimal("-92233720368547758.09")
+	if want := `ParseDecimal[Decimal]("-92233720368547758.09"): integer underflow`; err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+}
+
+func TestDecimalRescaleModes(t *testing.T) {
+	for _, mode := range roundingModes {
+		for c := -1000; c <= 1000; c++ {
+			got, err := dec(int64(c), 3).Rescale(1, mode)
+			if err != nil {
+				t.Fatalf("%v.Rescale(1, %d) error = %v", dec(int64(c), 3), mode, err)
+			}
+
+			if want := dec(int64(roundDiv(c, 100, mode)), 1); got != want {
+				t.Fatalf("%v.Rescale(1, %d) = %v; want %v", dec(int64(c), 3), mode, got, want)
+			}
+		}
+	}
+}
+
+func TestDecimalCmp(t *testing.T) {
+	ordered := []safemath.Decimal{
+		dec(math.MinInt64, 0),
+		dec(-1, 0),
+		dec(-1, 2),
+		{},
+		dec(1, 18),
+		dec(math.MaxInt64, 18),
+		dec(math.MaxInt64, 0),
+	}
+
+	for i, x := range ordered {
+		for j, y := range ordered {
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+			if got := x.Cmp(y); got != want {
+				t.Errorf("%v.Cmp(%v) = %d; want %d", x, y, got, want)
+			}
+		}
+	}
+
+	if dec(15, 1).Cmp(dec(150, 2)) != 0 {
+		t.Error("1.5 and 1.50 compare unequal")
+	}
+
+	if dec(-5, 3).Sign() != -1 || (safemath.Decimal{}).Sign() != 0 || dec(5, 3).Sign() != 1 {
+		t.Error("Sign returned unexpected result")
+	}
+}
+
+func TestDecimalString(t *testing.T) {
+	tests := []struct {
+		str string
+		v   safemath.Decimal
+	}{
+		{str: "0", v: safemath.Decimal{}},
+		{str: "0.00", v: dec(0, 2)},
+		{str: "12.345", v: dec(12345, 3)},
+		{str: "-0.005", v: dec(-5, 3)},
+		{str: "0.50", v: dec(50, 2)},
+		{str: "-12", v: dec(-12, 0)},
+		{str: "0.000000000000000001", v: dec(1, 18)},
+		{str: "9.223372036854775807", v: dec(math.MaxInt64, 18)},
+		{str: "-9223372036854775808", v: dec(math.MinInt64, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.str, func(t *testing.T) {
+			if got := tt.v.String(); got != tt.str {
+				t.Errorf("String() = %q; want %q", got, tt.str)
+			}
+
+			got, err := safemath.ParseDecimal(tt.str)
+			if err != nil || got != tt.v {
+				t.Errorf("ParseDecimal(%q) = %v, %v; want %v", tt.str, got, err, tt.v)
+			}
+		})
+	}
+}
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		str       string
+		want      safemath.Decimal
+		wantError error
+	}{
+		{str: "+7.", want: dec(7, 0)},
+		{str: ".5", want: dec(5, 1)},
+		{str: "-.25", want: dec(-25, 2)},
+		{str: "-0", want: dec(0, 0)},
+		{str: "1.5000000000000000000000", want: dec(15e17, 18)},
+		{str: "", wantError: strconv.ErrSyntax},
+		{str: "-", wantError: strconv.ErrSyntax},
+		{str: ".", wantError: strconv.ErrSyntax},
+		{str: "1.2.3", wantError: strconv.ErrSyntax},
+		{str: "1e3", wantError: strconv.ErrSyntax},
+		{str: " 1", wantError: strconv.ErrSyntax},
+		{str: "99999999999999999999999x", wantError: strconv.ErrSyntax},
+		{str: "9223372036854775808", wantError: safemath.ErrPositiveOverflow},
+		{str: "-9223372036854775809", wantError: safemath.ErrNegativeOverflow},
+		{str: "-92233720368547758.080", wantError: safemath.ErrNegativeOverflow},
+		{str: "99999999999999999999", wantError: safemath.ErrPositiveOverflow},
+		{str: "0.0000000000000000001", wantError: safemath.ErrTruncation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.str, func(t *testing.T) {
+			got, err := safemath.ParseDecimal(tt.str)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("got error %v, want %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	var opErr *safemath.OpError
+	if _, err := safemath.ParseDecimal("1.2.3"); !errors.As(err, &opErr) || opErr.Operands[0] != "1.2.3" {
+		t.Errorf("ParseDecimal error = %v; want *OpError carrying the input", err)
+	}
+}
@@ -0,0 +1,199 @@
+package safemath
+
+// DivFloor returns the quotient of a and b, rounded toward negative infinity.
+//
+// Like [Div], it returns ErrDivisionByZero if b is zero and ErrOverflow for
+// MinInt / -1.
+func DivFloor[T Integer](a, b T) (T, error) {
+	return divRound("DivFloor", a, b, RoundFloor)
+}
+
+// DivCeil returns the quotient of a and b, rounded toward positive infinity,
+// e.g. the number of pages needed to hold a items, b per page. Unlike
+// (a + b - 1) / b, it cannot overflow.
+//
+// Like [Div], it returns ErrDivisionByZero if b is zero and ErrOverflow for
+// MinInt / -1.
+func DivCeil[T Integer](a, b T) (T, error) {
+	return divRound("DivCeil", a, b, RoundCeil)
+}
+
+// DivRound returns the quotient of a and b, rounded according to mode.
+//
+// Like [Div], it returns ErrDivisionByZero if b is zero and ErrOverflow for
+// MinInt / -1.
+func DivRound[T Integer](a, b T, mode RoundingMode) (T, error) {
+	return divRound("DivRound", a, b, mode)
+}
+
+// DivEuclid returns the Euclidean quotient of a and b: the q for which
+// a = b*q + r with 0 <= r < |b|. It differs from [DivFloor] for negative b.
+//
+// Like [Div], it returns ErrDivisionByZero if b is zero and ErrOverflow for
+// MinInt / -1.
+func DivEuclid[T Integer](a, b T) (T, error) {
+	if b == 0 {
+		return 0, binaryOpError("DivEuclid", ErrDivisionByZero, nil, a, b)
+	}
+
+	if divOverflows(a, b) {
+		return 0, binaryOpError("DivEuclid", ErrOverflow, ErrPositiveOverflow, a, b)
+	}
+
+	q := a / b
+	if a%b < 0 {
+		// A negative remainder implies |b| >= 2, so q cannot overflow.
+		if b > 0 {
+			q--
+		} else {
+			q++
+		}
+	}
+
+	return q, nil
+}
+
+// RemEuclid returns the Euclidean remainder of a and b, which is always in
+// [0, |b|), making it suitable for ring buffers and calendar arithmetic.
+//
+// Like [Rem], it returns ErrDivisionByZero if b is zero and ErrOverflow for
+// MinInt % -1.
+func RemEuclid[T Integer](a, b T) (T, error) {
+	if b == 0 {
+		return 0, binaryOpError("RemEuclid", ErrDivisionByZero, nil, a, b)
+	}
+
+	if divOverflows(a, b) {
+		return 0, binaryOpError("RemEuclid", ErrOverflow, ErrPositiveOverflow, a, b)
+	}
+
+	r := a % b
+	if r < 0 {
+		// MinInt < r < 0, so r - b cannot overflow even for b = MinInt.
+		if b < 0 {
+			r -= b
+		} else {
+			r += b
+		}
+	}
+
+	return r, nil
+}
+
+// Mod returns the floored modulus of a and b, whose sign follows the divisor
+// like Python's % operator. It is the remainder of [DivFloor].
+//
+// Like [Rem], it returns ErrDivisionByZero if b is zero and ErrOverflow for
+// MinInt % -1.
+func Mod[T Integer](a, b T) (T, error) {
+	if b == 0 {
+		return 0, binaryOpError("Mod", ErrDivisionByZero, nil, a, b)
+	}
+
+	if divOverflows(a, b) {
+		return 0, binaryOpError("Mod", ErrOverflow, ErrPositiveOverflow, a, b)
+	}
+
+	r := a % b
+	if r != 0 && (r < 0) != (b < 0) {
+		// r and b have opposite signs, so the sum cannot overflow.
+		r += b
+	}
+
+	return r, nil
+}
+
+// MustDivFloor returns the floored quotient of a and b on success. Panics on
+// error.
+func MustDivFloor[T Integer](a, b T) T {
+	c, err := DivFloor(a, b)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustDivCeil returns the ceiled quotient of a and b on success. Panics on
+// error.
+func MustDivCeil[T Integer](a, b T) T {
+	c, err := DivCeil(a, b)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustDivRound returns the quotient of a and b, rounded according to mode, on
+// success. Panics on error.
+func MustDivRound[T Integer](a, b T, mode RoundingMode) T {
+	c, err := DivRound(a, b, mode)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustDivEuclid returns the Euclidean quotient of a and b on success. Panics
+// on error.
+func MustDivEuclid[T Integer](a, b T) T {
+	c, err := DivEuclid(a, b)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustRemEuclid returns the Euclidean remainder of a and b on success. Panics
+// on error.
+func MustRemEuclid[T Integer](a, b T) T {
+	c, err := RemEuclid(a, b)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustMod returns the floored modulus of a and b on success. Panics on error.
+func MustMod[T Integer](a, b T) T {
+	c, err := Mod(a, b)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// divOverflows reports whether a / b overflows, i.e. a is MinInt and b is -1.
+func divOverflows[T Integer](a, b T) bool {
+	return isSigned[T]() && b == ^T(0) && a != 0 && a == -a
+}
+
+// divRound implements the rounding divisions, reporting errors as op.
+func divRound[T Integer](op string, a, b T, mode RoundingMode) (T, error) {
+	if b == 0 {
+		return 0, binaryOpError(op, ErrDivisionByZero, nil, a, b)
+	}
+
+	q, overflow := OverflowingDiv(a, b)
+	if overflow {
+		return 0, binaryOpError(op, ErrOverflow, ErrPositiveOverflow, a, b)
+	}
+
+	// A non-zero remainder implies |b| >= 2, so |q| < MaxInt and adjusting
+	// it by one cannot overflow.
+	neg := (a < 0) != (b < 0)
+	if roundAway(mode, magnitude(a%b), magnitude(b), neg, q&1 == 1) {
+		if neg {
+			q--
+		} else {
+			q++
+		}
+	}
+
+	return q, nil
+}
@@ -0,0 +1,327 @@
+package safemath_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"go.dw1.io/safemath"
+)
+
+func TestDivRoundExhaustive8(t *testing.T) {
+	for _, mode := range roundingModes {
+		for a := math.MinInt8; a <= math.MaxInt8; a++ {
+			for b := math.MinInt8; b <= math.MaxInt8; b++ {
+				got, err := safemath.DivRound(int8(a), int8(b), mode)
+				switch {
+				case b == 0:
+					if !errors.Is(err, safemath.ErrDivisionByZero) {
+						t.Fatalf("DivRound[int8](%d, 0) error = %v; want ErrDivisionByZero", a, err)
+					}
+				case a == math.MinInt8 && b == -1:
+					if !errors.Is(err, safemath.ErrPositiveOverflow) {
+						t.Fatalf("DivRound[int8](%d, -1) error = %v; want ErrPositiveOverflow", a, err)
+					}
+				default:
+					if want := roundDiv(a, b, mode); err != nil || int(got) != want {
+						t.Fatalf("DivRound[int8](%d, %d, %d) = %d, %v; want %d", a, b, mode, got, err, want)
+					}
+				}
+			}
+		}
+
+		for a := 0; a <= math.MaxUint8; a++ {
+			for b := 1; b <= math.MaxUint8; b++ {
+				got, err := safemath.DivRound(uint8(a), uint8(b), mode)
+				if want := roundDiv(a, b, mode); err != nil || int(got) != want {
+					t.Fatalf("DivRound[uint8](%d, %d, %d) = %d, %v; want %d", a, b, mode, got, err, want)
+				}
+			}
+		}
+	}
+}
+
+func TestDivFloorCeil(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() (any, error)
+		want      any
+		wantError error
+	}{
+		{
+			name: "DivFloor negative",
+			fn:   func() (any, error) { return safemath.DivFloor(-7, 2) },
+			want: -4,
+		},
+		{
+			name: "DivFloor negative divisor",
+			fn:   func() (any, error) { return safemath.DivFloor(7, -2) },
+			want: -4,
+		},
+		{
+			name: "DivFloor both negative",
+			fn:   func() (any, error) { return safemath.DivFloor(-7, -2) },
+			want: 3,
+		},
+		{
+			name: "DivFloor int64 min",
+			fn:   func() (any, error) { return safemath.DivFloor(int64(math.MinInt64), 3) },
+			want: int64(-3074457345618258603),
+		},
+		{
+			name:      "DivFloor min / -1",
+			fn:        func() (any, error) { return safemath.DivFloor(int64(math.MinInt64), -1) },
+			want:      int64(0),
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name:      "DivFloor by zero",
+			fn:        func() (any, error) { return safemath.DivFloor(1, 0) },
+			want:      0,
+			wantError: safemath.ErrDivisionByZero,
+		},
+		{
+			name: "DivCeil positive",
+			fn:   func() (any, error) { return safemath.DivCeil(7, 2) },
+			want: 4,
+		},
+		{
+			name: "DivCeil negative",
+			fn:   func() (any, error) { return safemath.DivCeil(-7, 2) },
+			want: -3,
+		},
+		{
+			name: "DivCeil uint64 max",
+			fn:   func() (any, error) { return safemath.DivCeil(uint64(math.MaxUint64), 2) },
+			want: uint64(1 << 63),
+		},
+		{
+			name: "DivCeil int64 max",
+			fn:   func() (any, error) { return safemath.DivCeil(int64(math.MaxInt64), 2) },
+			want: int64(1 << 62),
+		},
+		{
+			name:      "DivCeil min / -1",
+			fn:        func() (any, error) { return safemath.DivCeil(int8(math.MinInt8), -1) },
+			want:      int8(0),
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name:      "DivCeil by zero",
+			fn:        func() (any, error) { return safemath.DivCeil(uint(1), 0) },
+			want:      uint(0),
+			wantError: safemath.ErrDivisionByZero,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("got error %v, want %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEuclidExhaustive8(t *testing.T) {
+	for a := math.MinInt8; a <= math.MaxInt8; a++ {
+		for b := math.MinInt8; b <= math.MaxInt8; b++ {
+			q, qErr := safemath.DivEuclid(int8(a), int8(b))
+			r, rErr := safemath.RemEuclid(int8(a), int8(b))
+			m, mErr := safemath.Mod(int8(a), int8(b))
+
+			switch {
+			case b == 0:
+				for _, err := range []error{qErr, rErr, mErr} {
+					if !errors.Is(err, safemath.ErrDivisionByZero) {
+						t.Fatalf("(%d, 0) error = %v; want ErrDivisionByZero", a, err)
+					}
+				}
+				continue
+			case a == math.MinInt8 && b == -1:
+				for _, err := range []error{qErr, rErr, mErr} {
+					if !errors.Is(err, safemath.ErrOverflow) {
+						t.Fatalf("(%d, -1) error = %v; want ErrOverflow", a, err)
+					}
+				}
+				if !errors.Is(qErr, safemath.ErrPositiveOverflow) {
+					t.Fatalf("DivEuclid(%d, -1) error = %v; want ErrPositiveOverflow", a, qErr)
+				}
+				continue
+			}
+
+			absB := b
+			if absB < 0 {
+				absB = -absB
+			}
+			wantR := (a%b + absB) % absB
+			wantQ := (a - wantR) / b
+			wantM := (a%b + b) % b
+
+			if qErr != nil || int(q) != wantQ {
+				t.Fatalf("DivEuclid[int8](%d, %d) = %d, %v; want %d", a, b, q, qErr, wantQ)
+			}
+			if rErr != nil || int(r) != wantR {
+				t.Fatalf("RemEuclid[int8](%d, %d) = %d, %v; want %d", a, b, r, rErr, wantR)
+			}
+			if mErr != nil || int(m) != wantM {
+				t.Fatalf("Mod[int8](%d, %d) = %d, %v; want %d", a, b, m, mErr, wantM)
+			}
+			if fq, _ := safemath.DivFloor(int8(a), int8(b)); int(fq)*b+wantM != a {
+				t.Fatalf("Mod[int8](%d, %d) = %d is not the remainder of DivFloor = %d", a, b, m, fq)
+			}
+		}
+	}
+
+	for a := 0; a <= math.MaxUint8; a++ {
+		for b := 1; b <= math.MaxUint8; b++ {
+			q, _ := safemath.DivEuclid(uint8(a), uint8(b))
+			r, _ := safemath.RemEuclid(uint8(a), uint8(b))
+			m, _ := safemath.Mod(uint8(a), uint8(b))
+			if int(q) != a/b || int(r) != a%b || int(m) != a%b {
+				t.Fatalf("(%d, %d) = %d, %d, %d; want %d, %d, %d", a, b, q, r, m, a/b, a%b, a%b)
+			}
+		}
+	}
+}
+
+func TestEuclid64(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() (any, error)
+		want      any
+		wantError error
+	}{
+		{
+			name: "RemEuclid min by min",
+			fn:   func() (any, error) { return safemath.RemEuclid(int64(math.MinInt64), math.MinInt64) },
+			want: int64(0),
+		},
+		{
+			name: "RemEuclid -1 by min",
+			fn:   func() (any, error) { return safemath.RemEuclid(int64(-1), math.MinInt64) },
+			want: int64(math.MaxInt64),
+		},
+		{
+			name: "DivEuclid -1 by min",
+			fn:   func() (any, error) { return safemath.DivEuclid(int64(-1), math.MinInt64) },
+			want: int64(1),
+		},
+		{
+			name: "Mod -1 by min",
+			fn:   func() (any, error) { return safemath.Mod(int64(-1), math.MinInt64) },
+			want: int64(-1),
+		},
+		{
+			name: "Mod max by min",
+			fn:   func() (any, error) { return safemath.Mod(int64(math.MaxInt64), math.MinInt64) },
+			want: int64(-1),
+		},
+		{
+			name:      "Mod min by -1",
+			fn:        func() (any, error) { return safemath.Mod(int64(math.MinInt64), -1) },
+			want:      int64(0),
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "RemEuclid uint64",
+			fn:   func() (any, error) { return safemath.RemEuclid(uint64(math.MaxUint64), 10) },
+			want: uint64(5),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("got error %v, want %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMustEuclid(t *testing.T) {
+	if got := safemath.MustDivEuclid(-7, -2); got != 4 {
+		t.Errorf("MustDivEuclid(-7, -2) = %d, want 4", got)
+	}
+	if got := safemath.MustRemEuclid(-7, -2); got != 1 {
+		t.Errorf("MustRemEuclid(-7, -2) = %d, want 1", got)
+	}
+	if got := safemath.MustMod(7, -2); got != -1 {
+		t.Errorf("MustMod(7, -2) = %d, want -1", got)
+	}
+
+	for name, fn := range map[string]func(){
+		"MustDivEuclid": func() { safemath.MustDivEuclid(int8(math.MinInt8), -1) },
+		"MustRemEuclid": func() { safemath.MustRemEuclid(1, 0) },
+		"MustMod":       func() { safemath.MustMod(uint(1), 0) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%s did not panic", name)
+				}
+			}()
+			fn()
+		})
+	}
+}
+
+func TestDivRoundError(t *testing.T) {
+	_, err := safemath.DivCeil(int16(math.MinInt16), -1)
+	if want := "DivCeil[int16](-32768, -1): integer overflow"; err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+}
+
+func TestMustDivRound(t *testing.T) {
+	if got := safemath.MustDivFloor(-1, 3); got != -1 {
+		t.Errorf("MustDivFloor(-1, 3) = %d, want -1", got)
+	}
+	if got := safemath.MustDivCeil(1, 3); got != 1 {
+		t.Errorf("MustDivCeil(1, 3) = %d, want 1", got)
+	}
+	if got := safemath.MustDivRound(5, 2, safemath.RoundHalfEven); got != 2 {
+		t.Errorf("MustDivRound(5, 2) = %d, want 2", got)
+	}
+
+	for name, fn := range map[string]func(){
+		"MustDivFloor": func() { safemath.MustDivFloor(1, 0) },
+		"MustDivCeil":  func() { safemath.MustDivCeil(int8(math.MinInt8), -1) },
+		"MustDivRound": func() { safemath.MustDivRound(1, 0, safemath.RoundHalfEven) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%s did not panic", name)
+				}
+			}()
+			fn()
+		})
+	}
+}
+
+func TestDivRoundInvalidMode(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("DivRound did not panic on invalid rounding mode")
+		}
+	}()
+	_, _ = safemath.DivRound(1, 2, safemath.RoundingMode(100))
+}
+
+func TestRoundingModeString(t *testing.T) {
+	if got := safemath.RoundHalfEven.String(); got != "RoundHalfEven" {
+		t.Errorf("RoundHalfEven.String() = %q", got)
+	}
+	if got := safemath.RoundingMode(100).String(); got != "RoundingMode(100)" {
+		t.Errorf("RoundingMode(100).String() = %q", got)
+	}
+}
@@ -4,13 +4,96 @@
 // preventing common bugs like overflow, underflow, and silent truncation that
 // standard Go operations might miss.
 //
-// Every arithmetic operation (+, -, *, /) is provided in two variants:
-//   - Error-returning: returns (T, error) (e.g., [Add], [Sub], [Mul], [Div])
+// Every arithmetic operation (+, -, *, /, %, unary -, abs, <<, >>, pow) is
+// provided in two variants:
+//   - Error-returning: returns (T, error) (e.g., [Add], [Sub], [Mul], [Div],
+//     [Rem], [Neg], [Abs], [Shl], [Shr], [Pow])
 //   - Panicking: returns T and panics on failure (e.g., [MustAdd], [MustSub], etc.)
 //
+// [ShrTruncated] shifts right like Go's >> operator instead, reporting whether
+// low bits were lost rather than failing.
+//
+// [Div] truncates toward zero like Go's / operator. [DivFloor], [DivCeil] and
+// [DivRound] round the quotient differently, with the same zero-divisor and
+// MinInt / -1 checks. For negative operands, [DivEuclid] and [RemEuclid]
+// keep the remainder non-negative, and [Mod] gives the remainder the sign of
+// the divisor.
+//
+// Saturating variants (e.g., [SaturatingAdd], [SaturatingMul],
+// [SaturatingConvert]) share the same overflow detection, but clamp the result
+// to the bounds of the type instead of failing. Conversions to the nearest
+// representable value are also available as [ConvertClamp], with
+// [ConvertClamped] reporting whether clamping happened and [ConvertAnyClamp]
+// accepting any integer value.
+//
+// Overflowing variants (e.g., [OverflowingAdd], [OverflowingMul]) return the
+// two's-complement wrapped result along with a bool reporting whether overflow
+// occurred.
+//
+// For computations that need the whole product, [MulFull] returns the 2N-bit
+// product of two N-bit integers as high and low halves, and [DivFull] divides
+// such a value back down with overflow checking. [MulDiv] computes a*b/c with
+// a double-width intermediate product and an explicit [RoundingMode], so it
+// only fails if the final quotient does not fit.
+//
+// Number-theoretic helpers [GCD], [LCM], [ExtendedGCD] and [ModInverse] work
+// on magnitudes internally, so MinInt operands are handled without overflow
+// and only unrepresentable results are reported. [ExtendedGCD] is limited to
+// [Signed] types, since its coefficients generally have opposite signs.
+//
+// Integer roots and logarithms ([Isqrt], [Iroot], [Cbrt], [ILog2], [ILog10],
+// [ILog]) are computed exactly, without going through floating point, and
+// reject arguments outside of their domain with [ErrDomain].
+//
+// Values whose domain is narrower than their type, such as percentages or
+// port numbers, can be checked against a [Bounds] range, whose operations
+// report [ErrOutOfRange] once a result leaves the range, along with
+// [ErrAboveRange] or [ErrBelowRange] rather than an overflow direction.
+//
+// When values outgrow 64 bits, [Int128] and [Uint128] provide the same checked
+// arithmetic at 128 bits without resorting to math/big.
+//
+// Fixed-point amounts such as prices are represented by [Decimal], an int64
+// coefficient with a decimal scale. Its multiplication, division and
+// [Decimal.Rescale] take the target scale and a [RoundingMode], and
+// [ParseDecimal] rejects input that would overflow or lose digits.
+//
+// Slices are aggregated with [Sum] and [Product], whose errors report the
+// index of the first element that overflowed as an [*IndexError]. [Min] and
+// [Max] return the extreme element, and report an empty slice as [ErrDomain].
+// [SumExact] accumulates in 128 bits instead, so it only fails if the final
+// total does not fit. [Mean] averages a slice with an explicit
+// [RoundingMode] without overflowing, and [Midpoint] does the same for two
+// values.
+//
+// Long formulas can be evaluated with a [Checked] accumulator, which records
+// the first failing step and turns every later operation into a no-op, so the
+// whole expression is checked once at the end.
+//
 // For type conversions, [Convert] ensures that the value can be represented
 // in the target type without data loss, handling both signed-to-unsigned and
 // size-based truncation checks. When the source value is only available as
 // an interface, [ConvertAny] (and [MustConvertAny]) perform the same checks
-// while also rejecting non-integer inputs.
+// while also rejecting non-integer inputs. [FromFloat] converts floating-point
+// values with an explicit [RoundingMode], rejecting NaN, infinities and
+// out-of-range values instead of producing implementation-defined results.
+// Conversely, [ToFloat64] and [ToFloat32] reject integers that would lose
+// precision. Results computed with math/big are narrowed back with
+// [FromBigInt], [FromBigRat] and [FromBigFloat], which follow the same
+// exactness rules as [Convert].
+//
+// Untrusted text can be parsed straight into any Integer type with [Parse]
+// (or [ParseBytes]), whose bounds are determined by the type parameter.
+//
+// Failures of the generic functions are reported as an [*OpError]
+// describing the operation, its operand type and values. It wraps one of the
+// sentinel errors ([ErrOverflow], [ErrTruncation], [ErrInvalidType],
+// [ErrDivisionByZero]), so callers can match with [errors.Is] and inspect the
+// inputs with [errors.As]. Overflows, and truncations of values beyond the
+// bounds of the target type, additionally match [ErrPositiveOverflow] or
+// [ErrNegativeOverflow], depending on which bound the exact result crossed.
+// Truncations that only lose precision, such as [Shr] shifting out a set bit
+// or [ToFloat64] rounding, carry no direction. The methods of [Int128] and [Uint128] return the
+// sentinel errors directly instead, and [ParseInt128] and [ParseUint128]
+// report malformed input as a [*strconv.NumError].
 package safemath
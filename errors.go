@@ -1,10 +1,191 @@
 package safemath
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
 
 var (
 	ErrOverflow       = errors.New("integer overflow/underflow")
 	ErrTruncation     = errors.New("integer type truncation")
 	ErrInvalidType    = errors.New("invalid integer type")
 	ErrDivisionByZero = errors.New("division by zero")
+	ErrNotFinite      = errors.New("floating-point value is not finite")
+	ErrInvalidBase    = errors.New("invalid base")
+	ErrNoInverse      = errors.New("no modular inverse")
+	ErrDomain         = errors.New("argument outside of domain")
+	ErrOutOfRange     = errors.New("value out of range")
+	ErrInvalidScale   = errors.New("invalid decimal scale")
+
+	// ErrSyntax is strconv.ErrSyntax, so parse errors match either.
+	ErrSyntax = strconv.ErrSyntax
+)
+
+// Direction errors refine ErrOverflow and ErrTruncation by telling whether
+// the exact result lies above the maximum or below the minimum of the type.
+// They are reported through [OpError.Direction], so an error matches both
+// the direction and its category:
+//
+//	errors.Is(err, ErrNegativeOverflow) && errors.Is(err, ErrOverflow)
+var (
+	ErrPositiveOverflow = errors.New("integer overflow")
+	ErrNegativeOverflow = errors.New("integer underflow")
+)
+
+// Range errors refine ErrOutOfRange in the same way, telling whether a
+// result lies above the maximum or below the minimum of a [Bounds]. They do
+// not match the direction errors above, since the type itself did not
+// overflow.
+var (
+	ErrAboveRange = errors.New("above maximum")
+	ErrBelowRange = errors.New("below minimum")
 )
+
+// OpError describes a failed operation, along with the operands that caused
+// it. It wraps one of the sentinel errors above, so errors.Is(err,
+// ErrOverflow) keeps working, while errors.As gives access to the inputs.
+type OpError struct {
+	Op       string // name of the operation, e.g. "Add"
+	Type     string // operand type, or the target type for conversions, e.g. "int32"
+	Operands []any  // operand values, in call order
+	Err      error  // underlying sentinel error, e.g. ErrOverflow
+
+	// Direction is ErrPositiveOverflow or ErrNegativeOverflow when the exact
+	// result lies beyond the bounds of Type, ErrAboveRange or ErrBelowRange
+	// when it lies outside of a [Bounds], and nil otherwise.
+	Direction error
+}
+
+func (e *OpError) Error() string {
+	var b strings.Builder
+
+	b.WriteString(e.Op)
+	b.WriteByte('[')
+	b.WriteString(e.Type)
+	b.WriteString("](")
+	for i, v := range e.Operands {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		if s, ok := v.(string); ok {
+			b.WriteString(strconv.Quote(s))
+		} else {
+			fmt.Fprint(&b, v)
+		}
+	}
+	b.WriteString("): ")
+	switch {
+	case e.Direction == nil:
+		b.WriteString(e.Err.Error())
+	case e.Err == ErrOverflow:
+		// The direction is more specific than "overflow/underflow".
+		b.WriteString(e.Direction.Error())
+	default:
+		b.WriteString(e.Err.Error())
+		b.WriteString(" (")
+		b.WriteString(e.Direction.Error())
+		b.WriteByte(')')
+	}
+
+	return b.String()
+}
+
+func (e *OpError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is the direction of e, so that errors.Is matches
+// e.g. ErrPositiveOverflow and ErrNegativeOverflow in addition to e.Err.
+func (e *OpError) Is(target error) bool {
+	return e.Direction != nil && target == e.Direction
+}
+
+// unaryOpError returns an *OpError for op on type T with the single operand
+// v. For conversions, T is the target type and v the source value.
+func unaryOpError[T, V any](op string, err, dir error, v V) error {
+	var zero T
+
+	return &OpError{
+		Op:        op,
+		Type:      fmt.Sprintf("%T", zero),
+		Operands:  []any{v},
+		Err:       err,
+		Direction: dir,
+	}
+}
+
+// binaryOpError returns an *OpError for op on the operands a and b, where a
+// has type T.
+func binaryOpError[T Integer, U any](op string, err, dir error, a T, b U) error {
+	return &OpError{
+		Op:        op,
+		Type:      fmt.Sprintf("%T", a),
+		Operands:  []any{a, b},
+		Err:       err,
+		Direction: dir,
+	}
+}
+
+// ternaryOpError returns an *OpError for op on the operands a, b and c.
+func ternaryOpError[T Integer](op string, err, dir error, a, b, c T) error {
+	return &OpError{
+		Op:        op,
+		Type:      fmt.Sprintf("%T", a),
+		Operands:  []any{a, b, c},
+		Err:       err,
+		Direction: dir,
+	}
+}
+
+// direction returns ErrNegativeOverflow if neg is true, and
+// ErrPositiveOverflow otherwise.
+func direction(neg bool) error {
+	if neg {
+		return ErrNegativeOverflow
+	}
+
+	return ErrPositiveOverflow
+}
+
+// rangeDirection returns ErrBelowRange if below is true, and ErrAboveRange
+// otherwise.
+func rangeDirection(below bool) error {
+	if below {
+		return ErrBelowRange
+	}
+
+	return ErrAboveRange
+}
+
+// StepError records the first failing step of a [Checked] computation.
+type StepError struct {
+	Step int    // 1-based index of the failing operation
+	Op   string // name of the failing operation, e.g. "Mul"
+	Err  error  // underlying error, e.g. an *OpError wrapping ErrOverflow
+}
+
+func (e *StepError) Error() string {
+	return "step " + strconv.Itoa(e.Step) + " (" + e.Op + "): " + e.Err.Error()
+}
+
+func (e *StepError) Unwrap() error {
+	return e.Err
+}
+
+// IndexError records the position of the first failing element of a slice
+// aggregate such as [Sum] or [Product].
+type IndexError struct {
+	Index int    // 0-based index of the failing element
+	Op    string // name of the aggregate, e.g. "Sum"
+	Err   error  // underlying error, e.g. an *OpError wrapping ErrOverflow
+}
+
+func (e *IndexError) Error() string {
+	return "index " + strconv.Itoa(e.Index) + " (" + e.Op + "): " + e.Err.Error()
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
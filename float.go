@@ -0,0 +1,113 @@
+package safemath
+
+import "math"
+
+// Float is a constraint that permits any floating-point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// FromFloat safely converts the floating-point value f to the Integer type
+// To, rounding any fractional part according to mode.
+//
+// Unlike a plain Go conversion, whose result is implementation-defined for
+// out-of-range values, FromFloat returns ErrNotFinite if f is NaN or an
+// infinity, and ErrTruncation if the rounded value cannot be represented in
+// To.
+func FromFloat[To Integer, F Float](f F, mode RoundingMode) (To, error) {
+	x := float64(f)
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return 0, unaryOpError[To]("FromFloat", ErrNotFinite, nil, f)
+	}
+
+	switch mode {
+	case RoundTruncate:
+		x = math.Trunc(x)
+	case RoundFloor:
+		x = math.Floor(x)
+	case RoundCeil:
+		x = math.Ceil(x)
+	case RoundHalfEven:
+		x = math.RoundToEven(x)
+	case RoundHalfAwayFromZero:
+		x = math.Round(x)
+	default:
+		panic(errInvalidRoundingMode)
+	}
+
+	if lo, hi := floatBounds[To](); x < lo || x >= hi {
+		return 0, unaryOpError[To]("FromFloat", ErrTruncation, direction(x < 0), f)
+	}
+
+	return To(x), nil
+}
+
+// ToFloat64 converts v to float64, or returns ErrTruncation if v cannot be
+// represented exactly (i.e., it needs more than 53 significant bits).
+func ToFloat64[From Integer](v From) (float64, error) {
+	f := float64(v)
+
+	// Guard the round trip: a value close to the maximum may round up to a
+	// float outside the range of From, where converting back is
+	// implementation-defined.
+	if _, hi := floatBounds[From](); f >= hi || From(f) != v {
+		return 0, unaryOpError[float64]("ToFloat64", ErrTruncation, nil, v)
+	}
+
+	return f, nil
+}
+
+// ToFloat32 converts v to float32, or returns ErrTruncation if v cannot be
+// represented exactly (i.e., it needs more than 24 significant bits).
+func ToFloat32[From Integer](v From) (float32, error) {
+	f := float32(v)
+
+	if _, hi := floatBounds[From](); float64(f) >= hi || From(f) != v {
+		return 0, unaryOpError[float32]("ToFloat32", ErrTruncation, nil, v)
+	}
+
+	return f, nil
+}
+
+// MustFromFloat safely converts f to the Integer type To on success. Panics
+// on error.
+func MustFromFloat[To Integer, F Float](f F, mode RoundingMode) To {
+	c, err := FromFloat[To](f, mode)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustToFloat64 converts v to float64 on success. Panics on error.
+func MustToFloat64[From Integer](v From) float64 {
+	c, err := ToFloat64(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustToFloat32 converts v to float32 on success. Panics on error.
+func MustToFloat32[From Integer](v From) float32 {
+	c, err := ToFloat32(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// floatBounds returns the range [lo, hi) of float64 values whose integer
+// part is representable by T. Both bounds are powers of two (or zero), so
+// they are exact.
+func floatBounds[T Integer]() (lo, hi float64) {
+	lo = float64(minOf[T]())
+	if isSigned[T]() {
+		return lo, -lo
+	}
+
+	return lo, math.Ldexp(1, int(bitSize[T]()))
+}
@@ -0,0 +1,261 @@
+package safemath_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"go.dw1.io/safemath"
+)
+
+func TestFromFloatRounding(t *testing.T) {
+	inputs := []float64{-2.5, -1.5, -1.2, -0.5, 0, 0.5, 1.2, 1.5, 1.7, 2.5}
+	want := map[safemath.RoundingMode][]int{
+		safemath.RoundTruncate:         {-2, -1, -1, 0, 0, 0, 1, 1, 1, 2},
+		safemath.RoundFloor:            {-3, -2, -2, -1, 0, 0, 1, 1, 1, 2},
+		safemath.RoundCeil:             {-2, -1, -1, 0, 0, 1, 2, 2, 2, 3},
+		safemath.RoundHalfEven:         {-2, -2, -1, 0, 0, 0, 1, 2, 2, 2},
+		safemath.RoundHalfAwayFromZero: {-3, -2, -1, -1, 0, 1, 1, 2, 2, 3},
+	}
+
+	for mode, results := range want {
+		for i, f := range inputs {
+			got, err := safemath.FromFloat[int](f, mode)
+			if err != nil {
+				t.Errorf("FromFloat(%v, %d) error = %v", f, mode, err)
+				continue
+			}
+			if got != results[i] {
+				t.Errorf("FromFloat(%v, %d) = %d; want %d", f, mode, got, results[i])
+			}
+		}
+	}
+}
+
+func TestFromFloat(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() (any, error)
+		want      any
+		wantError error
+	}{
+		{
+			name:      "NaN",
+			fn:        func() (any, error) { return safemath.FromFloat[int32](math.NaN(), safemath.RoundTruncate) },
+			want:      int32(0),
+			wantError: safemath.ErrNotFinite,
+		},
+		{
+			name:      "+Inf",
+			fn:        func() (any, error) { return safemath.FromFloat[int64](math.Inf(1), safemath.RoundTruncate) },
+			want:      int64(0),
+			wantError: safemath.ErrNotFinite,
+		},
+		{
+			name:      "float32 -Inf",
+			fn:        func() (any, error) { return safemath.FromFloat[int64](float32(math.Inf(-1)), safemath.RoundFloor) },
+			want:      int64(0),
+			wantError: safemath.ErrNotFinite,
+		},
+		{
+			name: "int8 max",
+			fn:   func() (any, error) { return safemath.FromFloat[int8](127.9, safemath.RoundTruncate) },
+			want: int8(127),
+		},
+		{
+			name:      "int8 max rounded up",
+			fn:        func() (any, error) { return safemath.FromFloat[int8](127.5, safemath.RoundHalfEven) },
+			want:      int8(0),
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name: "int8 min",
+			fn:   func() (any, error) { return safemath.FromFloat[int8](-128.9, safemath.RoundCeil) },
+			want: int8(-128),
+		},
+		{
+			name:      "int8 below min",
+			fn:        func() (any, error) { return safemath.FromFloat[int8](-128.1, safemath.RoundFloor) },
+			want:      int8(0),
+			wantError: safemath.ErrNegativeOverflow,
+		},
+		{
+			name: "uint8 small negative truncates to zero",
+			fn:   func() (any, error) { return safemath.FromFloat[uint8](-0.9, safemath.RoundTruncate) },
+			want: uint8(0),
+		},
+		{
+			name:      "uint8 negative",
+			fn:        func() (any, error) { return safemath.FromFloat[uint8](-0.9, safemath.RoundFloor) },
+			want:      uint8(0),
+			wantError: safemath.ErrTruncation,
+		},
+		{
+			name:      "int64 2^63",
+			fn:        func() (any, error) { return safemath.FromFloat[int64](math.Ldexp(1, 63), safemath.RoundTruncate) },
+			want:      int64(0),
+			wantError: safemath.ErrTruncation,
+		},
+		{
+			name: "int64 -2^63",
+			fn:   func() (any, error) { return safemath.FromFloat[int64](-math.Ldexp(1, 63), safemath.RoundTruncate) },
+			want: int64(math.MinInt64),
+		},
+		{
+			name: "uint64 largest below 2^64",
+			fn: func() (any, error) {
+				return safemath.FromFloat[uint64](math.Nextafter(math.Ldexp(1, 64), 0), safemath.RoundTruncate)
+			},
+			want: uint64(math.MaxUint64 - 2047),
+		},
+		{
+			name:      "uint64 2^64",
+			fn:        func() (any, error) { return safemath.FromFloat[uint64](math.Ldexp(1, 64), safemath.RoundTruncate) },
+			want:      uint64(0),
+			wantError: safemath.ErrTruncation,
+		},
+		{
+			name:      "float32 int32 2^31",
+			fn:        func() (any, error) { return safemath.FromFloat[int32](float32(math.MaxInt32), safemath.RoundTruncate) },
+			want:      int32(0),
+			wantError: safemath.ErrTruncation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("got error %v, want %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMustFromFloat(t *testing.T) {
+	if got := safemath.MustFromFloat[uint16](65535.4, safemath.RoundHalfEven); got != 65535 {
+		t.Errorf("MustFromFloat(65535.4) = %d; want 65535", got)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("MustFromFloat did not panic on NaN")
+		}
+	}()
+	safemath.MustFromFloat[int](math.NaN(), safemath.RoundTruncate)
+}
+
+func TestFromFloatInvalidMode(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("FromFloat did not panic on invalid rounding mode")
+		}
+	}()
+	_, _ = safemath.FromFloat[int](1.5, safemath.RoundingMode(100))
+}
+
+func TestToFloat(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() (any, error)
+		want      any
+		wantError error
+	}{
+		{
+			name: "int64 2^53",
+			fn:   func() (any, error) { return safemath.ToFloat64(int64(1) << 53) },
+			want: float64(1 << 53),
+		},
+		{
+			name:      "int64 2^53+1",
+			fn:        func() (any, error) { return safemath.ToFloat64(int64(1)<<53 + 1) },
+			want:      float64(0),
+			wantError: safemath.ErrTruncation,
+		},
+		{
+			name: "int64 -2^53-2",
+			fn:   func() (any, error) { return safemath.ToFloat64(-int64(1)<<53 - 2) },
+			want: float64(-(1 << 53) - 2),
+		},
+		{
+			name: "int64 min",
+			fn:   func() (any, error) { return safemath.ToFloat64(int64(math.MinInt64)) },
+			want: float64(math.MinInt64),
+		},
+		{
+			name:      "int64 max",
+			fn:        func() (any, error) { return safemath.ToFloat64(int64(math.MaxInt64)) },
+			want:      float64(0),
+			wantError: safemath.ErrTruncation,
+		},
+		{
+			name:      "uint64 max",
+			fn:        func() (any, error) { return safemath.ToFloat64(uint64(math.MaxUint64)) },
+			want:      float64(0),
+			wantError: safemath.ErrTruncation,
+		},
+		{
+			name: "uint64 2^63",
+			fn:   func() (any, error) { return safemath.ToFloat64(uint64(1) << 63) },
+			want: float64(1 << 63),
+		},
+		{
+			name: "int32 max",
+			fn:   func() (any, error) { return safemath.ToFloat64(int32(math.MaxInt32)) },
+			want: float64(math.MaxInt32),
+		},
+		{
+			name: "float32 int32 2^24",
+			fn:   func() (any, error) { return safemath.ToFloat32(int32(1) << 24) },
+			want: float32(1 << 24),
+		},
+		{
+			name:      "float32 int32 2^24+1",
+			fn:        func() (any, error) { return safemath.ToFloat32(int32(1)<<24 + 1) },
+			want:      float32(0),
+			wantError: safemath.ErrTruncation,
+		},
+		{
+			name:      "float32 int32 max",
+			fn:        func() (any, error) { return safemath.ToFloat32(int32(math.MaxInt32)) },
+			want:      float32(0),
+			wantError: safemath.ErrTruncation,
+		},
+		{
+			name: "float32 uint8",
+			fn:   func() (any, error) { return safemath.ToFloat32(uint8(255)) },
+			want: float32(255),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("got error %v, want %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMustToFloat(t *testing.T) {
+	if got := safemath.MustToFloat64(-42); got != -42 {
+		t.Errorf("MustToFloat64(-42) = %v; want -42", got)
+	}
+	if got := safemath.MustToFloat32(uint16(42)); got != 42 {
+		t.Errorf("MustToFloat32(42) = %v; want 42", got)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("MustToFloat64 did not panic on precision loss")
+		}
+	}()
+	safemath.MustToFloat64(uint64(math.MaxUint64))
+}
@@ -0,0 +1,181 @@
+package safemath
+
+import "math/bits"
+
+// MulFull returns the full-width product of a and b as two halves: hi holds
+// the upper bits and lo the lower bits of the 2N-bit product, where N is the
+// bit size of T. For signed types, hi carries the sign of the product and lo
+// holds the raw lower bits (which may appear negative).
+//
+// The product never overflows, so no error is returned. See [DivFull] for the
+// inverse operation.
+func MulFull[T Integer](a, b T) (hi, lo T) {
+	// Converting to uint64 sign-extends signed values, so the 128-bit
+	// product only needs the usual two's complement correction.
+	ua, ub := uint64(a), uint64(b)
+	hi64, lo64 := bits.Mul64(ua, ub)
+	if isSigned[T]() {
+		if a < 0 {
+			hi64 -= ub
+		}
+		if b < 0 {
+			hi64 -= ua
+		}
+	}
+
+	n := bitSize[T]()
+	if n == 64 {
+		return T(hi64), T(lo64)
+	}
+
+	// A 2N-bit product of narrower types fits entirely in lo64.
+	return T(lo64 >> n), T(lo64)
+}
+
+// DivFull returns the quotient and remainder of the 2N-bit value (hi, lo)
+// divided by d, where N is the bit size of T. The halves are interpreted as
+// returned by [MulFull], and division truncates toward zero like Go's / and %
+// operators.
+//
+// It returns an error wrapping ErrDivisionByZero if d is zero, and
+// ErrOverflow if the quotient does not fit in T.
+func DivFull[T Integer](hi, lo, d T) (quo, rem T, err error) {
+	if d == 0 {
+		return 0, 0, ternaryOpError("DivFull", ErrDivisionByZero, nil, hi, lo, d)
+	}
+
+	n := bitSize[T]()
+
+	// Assemble the dividend as a 128-bit two's complement value.
+	var hi64, lo64 uint64
+	if n == 64 {
+		hi64, lo64 = uint64(hi), uint64(lo)
+	} else {
+		lo64 = uint64(hi)<<n | uint64(lo)&(1<<n-1)
+		if hi < 0 {
+			hi64 = ^uint64(0)
+		}
+	}
+
+	// Divide magnitudes, then restore the signs.
+	negQuo, negRem := false, false
+	if isSigned[T]() && hi64>>63 == 1 {
+		hi64, lo64 = neg128(hi64, lo64)
+		negQuo, negRem = true, true
+	}
+
+	ud := uint64(d)
+	if d < 0 {
+		ud = -ud
+		negQuo = !negQuo
+	}
+
+	if hi64 >= ud {
+		return 0, 0, ternaryOpError("DivFull", ErrOverflow, direction(negQuo), hi, lo, d)
+	}
+
+	q, r := bits.Div64(hi64, lo64, ud)
+
+	limit := uint64(maxOf[T]())
+	if negQuo {
+		limit++
+	}
+
+	if q > limit {
+		return 0, 0, ternaryOpError("DivFull", ErrOverflow, direction(negQuo), hi, lo, d)
+	}
+
+	quo, rem = T(q), T(r)
+	if negQuo {
+		quo = -quo
+	}
+	if negRem {
+		rem = -rem
+	}
+
+	return quo, rem, nil
+}
+
+// MustDivFull returns the quotient and remainder of (hi, lo) divided by d on
+// success. Panics on error.
+func MustDivFull[T Integer](hi, lo, d T) (quo, rem T) {
+	quo, rem, err := DivFull(hi, lo, d)
+	if err != nil {
+		panic(err)
+	}
+
+	return quo, rem
+}
+
+// MulDiv returns a*b/c, rounded according to mode. The product is computed
+// at double width, so MulDiv only fails if c is zero (ErrDivisionByZero) or
+// the final quotient does not fit in T (ErrOverflow):
+//
+//	MulDiv(int64(math.MaxInt64), 3, 4, RoundTruncate) // 6917529027641081855, nil
+func MulDiv[T Integer](a, b, c T, mode RoundingMode) (T, error) {
+	if c == 0 {
+		return 0, ternaryOpError("MulDiv", ErrDivisionByZero, nil, a, b, c)
+	}
+
+	// Work on magnitudes; |MinInt64| still fits in a uint64.
+	ua, ub, uc := magnitude(a), magnitude(b), magnitude(c)
+	neg := (a < 0) != (b < 0) != (c < 0) && a != 0 && b != 0
+
+	hi, lo := bits.Mul64(ua, ub)
+	if hi >= uc {
+		// The quotient needs more than 64 bits.
+		return 0, ternaryOpError("MulDiv", ErrOverflow, direction(neg), a, b, c)
+	}
+
+	q, r := bits.Div64(hi, lo, uc)
+	up := roundAway(mode, r, uc, neg, q&1 == 1)
+
+	limit := uint64(maxOf[T]())
+	if neg {
+		limit++
+	}
+
+	// Checking q == limit before rounding up also avoids wrapping q.
+	if q > limit || up && q == limit {
+		return 0, ternaryOpError("MulDiv", ErrOverflow, direction(neg), a, b, c)
+	}
+
+	if up {
+		q++
+	}
+
+	v := T(q)
+	if neg {
+		v = -v
+	}
+
+	return v, nil
+}
+
+// MustMulDiv returns a*b/c, rounded according to mode, on success. Panics on
+// error.
+func MustMulDiv[T Integer](a, b, c T, mode RoundingMode) T {
+	v, err := MulDiv(a, b, c, mode)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+// magnitude returns |v| as a uint64, which cannot overflow even for MinInt64.
+func magnitude[T Integer](v T) uint64 {
+	if v < 0 {
+		return -uint64(v)
+	}
+
+	return uint64(v)
+}
+
+// neg128 returns the two's complement negation of the 128-bit value (hi, lo).
+func neg128(hi, lo uint64) (uint64, uint64) {
+	lo, borrow := bits.Sub64(0, lo, 0)
+	hi, _ = bits.Sub64(0, hi, borrow)
+
+	return hi, lo
+}
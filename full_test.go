@@ -0,0 +1,420 @@
+package safemath_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"go.dw1.io/safemath"
+)
+
+func TestMulFullExhaustive8(t *testing.T) {
+	for a := math.MinInt8; a <= math.MaxInt8; a++ {
+		for b := math.MinInt8; b <= math.MaxInt8; b++ {
+			want := int16(a * b)
+			hi, lo := safemath.MulFull(int8(a), int8(b))
+			if hi != int8(want>>8) || lo != int8(want) {
+				t.Fatalf("MulFull[int8](%d, %d) = (%d, %d); want (%d, %d)", a, b, hi, lo, int8(want>>8), int8(want))
+			}
+		}
+	}
+
+	for a := 0; a <= math.MaxUint8; a++ {
+		for b := 0; b <= math.MaxUint8; b++ {
+			want := uint16(a * b)
+			hi, lo := safemath.MulFull(uint8(a), uint8(b))
+			if hi != uint8(want>>8) || lo != uint8(want) {
+				t.Fatalf("MulFull[uint8](%d, %d) = (%d, %d); want (%d, %d)", a, b, hi, lo, uint8(want>>8), uint8(want))
+			}
+		}
+	}
+}
+
+func TestDivFullExhaustive8(t *testing.T) {
+	for n := math.MinInt16; n <= math.MaxInt16; n += 7 {
+		hi, lo := int8(n>>8), int8(n)
+		for d := math.MinInt8; d <= math.MaxInt8; d++ {
+			quo, rem, err := safemath.DivFull(hi, lo, int8(d))
+			switch {
+			case d == 0:
+				if !errors.Is(err, safemath.ErrDivisionByZero) {
+					t.Fatalf("DivFull[int8](%d, 0) error = %v; want ErrDivisionByZero", n, err)
+				}
+			case n/d < math.MinInt8 || n/d > math.MaxInt8:
+				if !errors.Is(err, ovfDirection(n/d < 0)) {
+					t.Fatalf("DivFull[int8](%d, %d) error = %v; want %v", n, d, err, ovfDirection(n/d < 0))
+				}
+			default:
+				if err != nil || int(quo) != n/d || int(rem) != n%d {
+					t.Fatalf("DivFull[int8](%d, %d) = (%d, %d, %v); want (%d, %d)", n, d, quo, rem, err, n/d, n%d)
+				}
+			}
+		}
+	}
+
+	for n := 0; n <= math.MaxUint16; n += 7 {
+		hi, lo := uint8(n>>8), uint8(n)
+		for d := 1; d <= math.MaxUint8; d++ {
+			quo, rem, err := safemath.DivFull(hi, lo, uint8(d))
+			if n/d > math.MaxUint8 {
+				if !errors.Is(err, safemath.ErrPositiveOverflow) {
+					t.Fatalf("DivFull[uint8](%d, %d) error = %v; want ErrPositiveOverflow", n, d, err)
+				}
+				continue
+			}
+			if err != nil || int(quo) != n/d || int(rem) != n%d {
+				t.Fatalf("DivFull[uint8](%d, %d) = (%d, %d, %v); want (%d, %d)", n, d, quo, rem, err, n/d, n%d)
+			}
+		}
+	}
+}
+
+func TestMulFull64(t *testing.T) {
+	tests := []struct {
+		name   string
+		a, b   int64
+		hi, lo int64
+	}{
+		{name: "small", a: 3, b: -4, hi: -1, lo: -12},
+		{name: "max * max", a: math.MaxInt64, b: math.MaxInt64, hi: math.MaxInt64 >> 1, lo: 1},
+		{name: "min * min", a: math.MinInt64, b: math.MinInt64, hi: 1 << 62, lo: 0},
+		{name: "min * -1", a: math.MinInt64, b: -1, hi: 0, lo: math.MinInt64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hi, lo := safemath.MulFull(tt.a, tt.b)
+			if hi != tt.hi || lo != tt.lo {
+				t.Errorf("got (%d, %d), want (%d, %d)", hi, lo, tt.hi, tt.lo)
+			}
+
+			quo, rem, err := safemath.DivFull(hi, lo, tt.b)
+			if err != nil || quo != tt.a || rem != 0 {
+				t.Errorf("round trip got (%d, %d, %v), want (%d, 0, nil)", quo, rem, err, tt.a)
+			}
+		})
+	}
+
+	hi, lo := safemath.MulFull[uint64](math.MaxUint64, math.MaxUint64)
+	if hi != math.MaxUint64-1 || lo != 1 {
+		t.Errorf("MulFull[uint64](max, max) = (%d, %d)", hi, lo)
+	}
+}
+
+func TestDivFull(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() error
+		wantError error
+	}{
+		{
+			name: "divide by zero",
+			fn: func() error {
+				_, _, err := safemath.DivFull[uint64](0, 1, 0)
+				return err
+			},
+			wantError: safemath.ErrDivisionByZero,
+		},
+		{
+			name: "uint64 quotient overflow",
+			fn: func() error {
+				_, _, err := safemath.DivFull[uint64](1, 0, 1)
+				return err
+			},
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "int64 min / -1",
+			fn: func() error {
+				_, _, err := safemath.DivFull[int64](-1, math.MinInt64, -1)
+				return err
+			},
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name: "int64 negative quotient overflow",
+			fn: func() error {
+				_, _, err := safemath.DivFull[int64](-1, 0, 1)
+				return err
+			},
+			wantError: safemath.ErrNegativeOverflow,
+		},
+		{
+			name: "int64 min / 1",
+			fn: func() error {
+				quo, _, err := safemath.DivFull[int64](-1, math.MinInt64, 1)
+				if err != nil {
+					return err
+				}
+				if quo != math.MinInt64 {
+					t.Errorf("want %d, got %d", math.MinInt64, quo)
+				}
+				return nil
+			},
+			wantError: nil,
+		},
+		{
+			name: "uint64 ok",
+			fn: func() error {
+				// (2^64 + 5) / 3
+				quo, rem, err := safemath.DivFull[uint64](1, 5, 3)
+				if err != nil {
+					return err
+				}
+				if quo != 6148914691236517207 || rem != 0 {
+					t.Errorf("want (6148914691236517207, 0), got (%d, %d)", quo, rem)
+				}
+				return nil
+			},
+			wantError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.fn(); !errors.Is(err, tt.wantError) {
+				t.Errorf("got error %v, want %v", err, tt.wantError)
+			}
+		})
+	}
+
+	var opErr *safemath.OpError
+	if _, _, err := safemath.DivFull[uint64](1, 0, 1); !errors.As(err, &opErr) || opErr.Op != "DivFull" || len(opErr.Operands) != 3 {
+		t.Errorf("DivFull error = %#v; want *OpError with three operands", err)
+	}
+}
+
+// roundDiv is a reference implementation of n/d rounded according to mode.
+func roundDiv(n, d int, mode safemath.RoundingMode) int {
+	q, r := n/d, n%d
+	if r == 0 {
+		return q
+	}
+
+	neg := (n < 0) != (d < 0)
+	away := q + 1
+	if neg {
+		away = q - 1
+	}
+
+	if r < 0 {
+		r = -r
+	}
+	if d < 0 {
+		d = -d
+	}
+
+	switch mode {
+	case safemath.RoundFloor:
+		if neg {
+			return away
+		}
+	case safemath.RoundCeil:
+		if !neg {
+			return away
+		}
+	case safemath.RoundHalfEven:
+		if 2*r > d || 2*r == d && q%2 != 0 {
+			return away
+		}
+	case safemath.RoundHalfAwayFromZero:
+		if 2*r >= d {
+			return away
+		}
+	}
+
+	return q
+}
+
+var roundingModes = []safemath.RoundingMode{
+	safemath.RoundTruncate,
+	safemath.RoundFloor,
+	safemath.RoundCeil,
+	safemath.RoundHalfEven,
+	safemath.RoundHalfAwayFromZero,
+}
+
+func TestMulDivExhaustive8(t *testing.T) {
+	for _, mode := range roundingModes {
+		for a := math.MinInt8; a <= math.MaxInt8; a++ {
+			for b := math.MinInt8; b <= math.MaxInt8; b += 3 {
+				for _, c := range []int{math.MinInt8, -7, -2, -1, 0, 1, 2, 3, 10, math.MaxInt8} {
+					got, err := safemath.MulDiv(int8(a), int8(b), int8(c), mode)
+					if c == 0 {
+						if !errors.Is(err, safemath.ErrDivisionByZero) {
+							t.Fatalf("MulDiv[int8](%d, %d, 0) error = %v; want ErrDivisionByZero", a, b, err)
+						}
+						continue
+					}
+
+					want := roundDiv(a*b, c, mode)
+					if want < math.MinInt8 || want > math.MaxInt8 {
+						if !errors.Is(err, safemath.ErrOverflow) || !errors.Is(err, ovfDirection(want < 0)) {
+							t.Fatalf("MulDiv[int8](%d, %d, %d, %d) = %d, %v; want overflow", a, b, c, mode, got, err)
+						}
+						continue
+					}
+					if err != nil || int(got) != want {
+						t.Fatalf("MulDiv[int8](%d, %d, %d, %d) = %d, %v; want %d", a, b, c, mode, got, err, want)
+					}
+				}
+			}
+		}
+
+		for a := 0; a <= math.MaxUint8; a++ {
+			for b := 0; b <= math.MaxUint8; b += 3 {
+				for _, c := range []int{1, 2, 3, 10, 255} {
+					got, err := safemath.MulDiv(uint8(a), uint8(b), uint8(c), mode)
+					want := roundDiv(a*b, c, mode)
+					if want > math.MaxUint8 {
+						if !errors.Is(err, safemath.ErrPositiveOverflow) {
+							t.Fatalf("MulDiv[uint8](%d, %d, %d, %d) = %d, %v; want overflow", a, b, c, mode, got, err)
+						}
+						continue
+					}
+					if err != nil || int(got) != want {
+						t.Fatalf("MulDiv[uint8](%d, %d, %d, %d) = %d, %v; want %d", a, b, c, mode, got, err, want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func ovfDirection(neg bool) error {
+	if neg {
+		return safemath.ErrNegativeOverflow
+	}
+
+	return safemath.ErrPositiveOverflow
+}
+
+func TestMulDiv64(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() (any, error)
+		want      any
+		wantError error
+	}{
+		{
+			name: "int64 max * 3 / 4",
+			fn: func() (any, error) {
+				return safemath.MulDiv(int64(math.MaxInt64), 3, 4, safemath.RoundTruncate)
+			},
+			want: int64(6917529027641081855),
+		},
+		{
+			name: "int64 min * min / min",
+			fn: func() (any, error) {
+				return safemath.MulDiv(int64(math.MinInt64), math.MinInt64, math.MinInt64, safemath.RoundTruncate)
+			},
+			want: int64(math.MinInt64),
+		},
+		{
+			name: "int64 min * -1 / 1",
+			fn: func() (any, error) {
+				return safemath.MulDiv(int64(math.MinInt64), -1, 1, safemath.RoundTruncate)
+			},
+			want:      int64(0),
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name: "int64 min * 1 / 1",
+			fn: func() (any, error) {
+				return safemath.MulDiv(int64(math.MinInt64), 1, 1, safemath.RoundTruncate)
+			},
+			want: int64(math.MinInt64),
+		},
+		{
+			name: "uint64 max * max / max",
+			fn: func() (any, error) {
+				return safemath.MulDiv(uint64(math.MaxUint64), math.MaxUint64, math.MaxUint64, safemath.RoundCeil)
+			},
+			want: uint64(math.MaxUint64),
+		},
+		{
+			name: "uint64 rounds up past max",
+			fn: func() (any, error) {
+				return safemath.MulDiv(uint64(math.MaxUint64), math.MaxUint64, math.MaxUint64-1, safemath.RoundTruncate)
+			},
+			want:      uint64(0),
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			// (2^64-1) * (2^63+1) / 2^63 is just below 2^64 + 2.
+			name: "uint64 quotient needs 65 bits",
+			fn: func() (any, error) {
+				return safemath.MulDiv(uint64(math.MaxUint64), 1<<63+1, 1<<63, safemath.RoundTruncate)
+			},
+			want:      uint64(0),
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			// The quotient is exact, so it is not rounded past the maximum.
+			name: "uint64 exact at max",
+			fn: func() (any, error) {
+				return safemath.MulDiv(uint64(math.MaxUint64), 3, 3, safemath.RoundCeil)
+			},
+			want: uint64(math.MaxUint64),
+		},
+		{
+			name: "uint64 ceil past max",
+			fn: func() (any, error) {
+				return safemath.MulDiv(uint64(math.MaxUint64-1), 1<<63+1, 1<<63, safemath.RoundCeil)
+			},
+			want:      uint64(0),
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name:      "division by zero",
+			fn:        func() (any, error) { return safemath.MulDiv(1, 2, 0, safemath.RoundTruncate) },
+			want:      0,
+			wantError: safemath.ErrDivisionByZero,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("got error %v, want %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMulDivError(t *testing.T) {
+	_, err := safemath.MulDiv(int32(math.MinInt32), 2, 1, safemath.RoundTruncate)
+	if want := "MulDiv[int32](-2147483648, 2, 1): integer underflow"; err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+}
+
+func TestMustMulDiv(t *testing.T) {
+	if got := safemath.MustMulDiv(uint32(math.MaxUint32), 10, 20, safemath.RoundHalfEven); got != 1<<31 {
+		t.Errorf("MustMulDiv = %d, want %d", got, 1<<31)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("MustMulDiv did not panic")
+		}
+	}()
+	safemath.MustMulDiv(uint32(math.MaxUint32), 2, 1, safemath.RoundTruncate)
+}
+
+func TestMustDivFull(t *testing.T) {
+	if quo, rem := safemath.MustDivFull[int32](0, 7, 2); quo != 3 || rem != 1 {
+		t.Errorf("MustDivFull(0, 7, 2) = (%d, %d); want (3, 1)", quo, rem)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("MustDivFull did not panic on overflow")
+		}
+	}()
+	safemath.MustDivFull[int32](1, 0, 1)
+}
@@ -0,0 +1,195 @@
+package safemath
+
+import "math/bits"
+
+// GCD returns the greatest common divisor of a and b, which is always
+// non-negative. By convention, GCD(0, 0) is 0.
+//
+// For signed types, the result overflows if it is |MinInt|, i.e. when both
+// operands are 0 or MinInt and at least one of them is MinInt.
+func GCD[T Integer](a, b T) (T, error) {
+	g := gcd64(magnitude(a), magnitude(b))
+	if g > uint64(maxOf[T]()) {
+		return 0, binaryOpError("GCD", ErrOverflow, ErrPositiveOverflow, a, b)
+	}
+
+	return T(g), nil
+}
+
+// LCM returns the least common multiple of a and b, which is always
+// non-negative, or ErrOverflow if it does not fit in T. The LCM is 0 if
+// either operand is 0.
+func LCM[T Integer](a, b T) (T, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+
+	ua, ub := magnitude(a), magnitude(b)
+	hi, lo := bits.Mul64(ua/gcd64(ua, ub), ub)
+	if hi != 0 || lo > uint64(maxOf[T]()) {
+		return 0, binaryOpError("LCM", ErrOverflow, ErrPositiveOverflow, a, b)
+	}
+
+	return T(lo), nil
+}
+
+// ExtendedGCD returns g = [GCD](a, b) along with Bézout coefficients x and y
+// such that a*x + b*y = g.
+//
+// Bézout coefficients of non-trivial inputs have opposite signs, so T must
+// be a signed type. Intermediate coefficients are computed with 128-bit
+// arithmetic, and ErrOverflow is returned if g, x or y does not fit in T
+// (e.g., the GCD of MinInt and 0). Use [ModInverse], which accepts any
+// Integer type, when only the inverse modulo a number is needed.
+func ExtendedGCD[T Signed](a, b T) (g, x, y T, err error) {
+	ug, ux, uy := extendedGCD(magnitude(a), magnitude(b))
+
+	// Restore the signs of the operands on their coefficients.
+	if a < 0 {
+		ux, _ = ux.Neg()
+	}
+	if b < 0 {
+		uy, _ = uy.Neg()
+	}
+
+	if ug > uint64(maxOf[T]()) {
+		return 0, 0, 0, binaryOpError("ExtendedGCD", ErrOverflow, ErrPositiveOverflow, a, b)
+	}
+
+	x, ok := int128To[T](ux)
+	if !ok {
+		return 0, 0, 0, binaryOpError("ExtendedGCD", ErrOverflow, direction(ux.Hi < 0), a, b)
+	}
+
+	y, ok = int128To[T](uy)
+	if !ok {
+		return 0, 0, 0, binaryOpError("ExtendedGCD", ErrOverflow, direction(uy.Hi < 0), a, b)
+	}
+
+	return T(ug), x, y, nil
+}
+
+// ModInverse returns the inverse of a modulo m: the x in [0, |m|) such that
+// a*x ≡ 1 (mod m).
+//
+// It returns ErrDivisionByZero if m is zero, and ErrNoInverse if a and m are
+// not coprime.
+func ModInverse[T Integer](a, m T) (T, error) {
+	if m == 0 {
+		return 0, binaryOpError("ModInverse", ErrDivisionByZero, nil, a, m)
+	}
+
+	// Reduce a to its Euclidean remainder modulo |m|.
+	um := magnitude(m)
+	ua := magnitude(a) % um
+	if a < 0 && ua != 0 {
+		ua = um - ua
+	}
+
+	g, x, _ := extendedGCD(ua, um)
+	if g != 1 {
+		return 0, binaryOpError("ModInverse", ErrNoInverse, nil, a, m)
+	}
+
+	// |x| <= |m|, so bringing it into [0, |m|) takes at most one addition.
+	if x.Hi < 0 {
+		x, _ = x.Add(Int128{Lo: um})
+	}
+
+	// The inverse is below |m| <= |MinInt|, so it fits in T.
+	return T(x.Lo % um), nil
+}
+
+// MustGCD returns the greatest common divisor of a and b on success. Panics
+// on error.
+func MustGCD[T Integer](a, b T) T {
+	c, err := GCD(a, b)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustLCM returns the least common multiple of a and b on success. Panics on
+// error.
+func MustLCM[T Integer](a, b T) T {
+	c, err := LCM(a, b)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustExtendedGCD returns the GCD of a and b and its Bézout coefficients on
+// success. Panics on error.
+func MustExtendedGCD[T Signed](a, b T) (g, x, y T) {
+	g, x, y, err := ExtendedGCD(a, b)
+	if err != nil {
+		panic(err)
+	}
+
+	return g, x, y
+}
+
+// MustModInverse returns the inverse of a modulo m on success. Panics on
+// error.
+func MustModInverse[T Integer](a, m T) T {
+	c, err := ModInverse(a, m)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// gcd64 returns the greatest common divisor of a and b using Stein's binary
+// algorithm.
+func gcd64(a, b uint64) uint64 {
+	if a == 0 {
+		return b
+	}
+	if b == 0 {
+		return a
+	}
+
+	shift := bits.TrailingZeros64(a | b)
+	a >>= bits.TrailingZeros64(a)
+	for b != 0 {
+		b >>= bits.TrailingZeros64(b)
+		if a > b {
+			a, b = b, a
+		}
+		b -= a
+	}
+
+	return a << shift
+}
+
+// extendedGCD returns g = gcd(a, b) and coefficients x and y such that
+// a*x + b*y = g, using the iterative extended Euclidean algorithm. The
+// coefficients never exceed max(a, b) in magnitude, so the 128-bit
+// arithmetic cannot overflow.
+func extendedGCD(a, b uint64) (g uint64, x, y Int128) {
+	x0, x1 := Int128{Lo: 1}, Int128{}
+	y0, y1 := Int128{}, Int128{Lo: 1}
+	for b != 0 {
+		q := Int128{Lo: a / b}
+		a, b = b, a%b
+
+		x0, x1 = bezoutStep(x0, x1, q)
+		y0, y1 = bezoutStep(y0, y1, q)
+	}
+
+	return a, x0, y0
+}
+
+// bezoutStep advances a pair of Bézout coefficients by one Euclidean step
+// with quotient q, returning (c1, c0 - q*c1).
+func bezoutStep(c0, c1, q Int128) (Int128, Int128) {
+	p, _ := q.Mul(c1)
+	c2, _ := c0.Sub(p)
+
+	return c1, c2
+}
@@ -0,0 +1,208 @@
+package safemath_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"go.dw1.io/safemath"
+)
+
+func refGCD(a, b int) int {
+	if a < 0 {
+		a = -a
+	}
+	if b < 0 {
+		b = -b
+	}
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
+
+func TestGCDLCMExhaustive8(t *testing.T) {
+	for a := math.MinInt8; a <= math.MaxInt8; a++ {
+		for b := math.MinInt8; b <= math.MaxInt8; b++ {
+			g := refGCD(a, b)
+			got, err := safemath.GCD(int8(a), int8(b))
+			if g > math.MaxInt8 {
+				if !errors.Is(err, safemath.ErrPositiveOverflow) {
+					t.Fatalf("GCD[int8](%d, %d) = %d, %v; want overflow", a, b, got, err)
+				}
+			} else if err != nil || int(got) != g {
+				t.Fatalf("GCD[int8](%d, %d) = %d, %v; want %d", a, b, got, err, g)
+			}
+
+			l := 0
+			if g != 0 {
+				l = refGCD(a/g*b, 0)
+			}
+			gotL, err := safemath.LCM(int8(a), int8(b))
+			if l > math.MaxInt8 {
+				if !errors.Is(err, safemath.ErrPositiveOverflow) {
+					t.Fatalf("LCM[int8](%d, %d) = %d, %v; want overflow", a, b, gotL, err)
+				}
+			} else if err != nil || int(gotL) != l {
+				t.Fatalf("LCM[int8](%d, %d) = %d, %v; want %d", a, b, gotL, err, l)
+			}
+		}
+	}
+
+	for a := 0; a <= math.MaxUint8; a++ {
+		for b := 0; b <= math.MaxUint8; b++ {
+			if got, err := safemath.GCD(uint8(a), uint8(b)); err != nil || int(got) != refGCD(a, b) {
+				t.Fatalf("GCD[uint8](%d, %d) = %d, %v; want %d", a, b, got, err, refGCD(a, b))
+			}
+		}
+	}
+}
+
+func TestExtendedGCDExhaustive8(t *testing.T) {
+	for a := math.MinInt8; a <= math.MaxInt8; a++ {
+		for b := math.MinInt8; b <= math.MaxInt8; b++ {
+			g, x, y, err := safemath.ExtendedGCD(int8(a), int8(b))
+			if want := refGCD(a, b); want > math.MaxInt8 {
+				if !errors.Is(err, safemath.ErrPositiveOverflow) {
+					t.Fatalf("ExtendedGCD[int8](%d, %d) error = %v; want overflow", a, b, err)
+				}
+				continue
+			} else if err != nil || int(g) != want {
+				t.Fatalf("ExtendedGCD[int8](%d, %d) = %d, %v; want %d", a, b, g, err, want)
+			}
+			if a*int(x)+b*int(y) != int(g) {
+				t.Fatalf("ExtendedGCD[int8](%d, %d) = %d, %d, %d; not a Bézout identity", a, b, g, x, y)
+			}
+		}
+	}
+}
+
+func TestModInverseExhaustive8(t *testing.T) {
+	for a := math.MinInt8; a <= math.MaxInt8; a++ {
+		for m := math.MinInt8; m <= math.MaxInt8; m++ {
+			got, err := safemath.ModInverse(int8(a), int8(m))
+			switch {
+			case m == 0:
+				if !errors.Is(err, safemath.ErrDivisionByZero) {
+					t.Fatalf("ModInverse[int8](%d, 0) error = %v; want ErrDivisionByZero", a, err)
+				}
+			case refGCD(a, m) != 1:
+				if !errors.Is(err, safemath.ErrNoInverse) {
+					t.Fatalf("ModInverse[int8](%d, %d) = %d, %v; want ErrNoInverse", a, m, got, err)
+				}
+			default:
+				absM := m
+				if absM < 0 {
+					absM = -absM
+				}
+				if err != nil || got < 0 || int(got) >= absM || ((a*int(got)%absM)+absM)%absM != 1%absM {
+					t.Fatalf("ModInverse[int8](%d, %d) = %d, %v", a, m, got, err)
+				}
+			}
+		}
+	}
+}
+
+func TestNumberTheory64(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() (any, error)
+		want      any
+		wantError error
+	}{
+		{
+			name:      "GCD min, 0",
+			fn:        func() (any, error) { return safemath.GCD(int64(math.MinInt64), 0) },
+			want:      int64(0),
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "GCD min, max",
+			fn:   func() (any, error) { return safemath.GCD(int64(math.MinInt64), math.MaxInt64) },
+			want: int64(1),
+		},
+		{
+			name: "GCD uint64",
+			fn:   func() (any, error) { return safemath.GCD(uint64(math.MaxUint64), 1<<32+1) },
+			want: uint64(1<<32 + 1),
+		},
+		{
+			name:      "LCM int64 periods",
+			fn:        func() (any, error) { return safemath.LCM(int64(1<<40+1), 1<<40-1) },
+			want:      int64(0),
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name: "LCM min, 2",
+			fn:   func() (any, error) { return safemath.LCM(int64(math.MinInt64), 2) },
+			want: int64(0),
+			// |MinInt64| is not representable.
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "LCM uint64",
+			fn:   func() (any, error) { return safemath.LCM(uint64(1<<32-1), 1<<32+1) },
+			want: uint64(math.MaxUint64),
+		},
+		{
+			name: "ModInverse uint64",
+			fn:   func() (any, error) { return safemath.ModInverse(uint64(3), math.MaxUint64-1) },
+			want: uint64(6148914691236517205),
+		},
+		{
+			name: "ModInverse negative",
+			fn:   func() (any, error) { return safemath.ModInverse(int64(-3), math.MinInt64) },
+			want: int64(6148914691236517205),
+		},
+		{
+			name:      "ModInverse not coprime",
+			fn:        func() (any, error) { return safemath.ModInverse(uint64(6), 9) },
+			want:      uint64(0),
+			wantError: safemath.ErrNoInverse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("got error %v, want %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMustNumberTheory(t *testing.T) {
+	if got := safemath.MustGCD(12, -18); got != 6 {
+		t.Errorf("MustGCD(12, -18) = %d, want 6", got)
+	}
+	if got := safemath.MustLCM(-4, 6); got != 12 {
+		t.Errorf("MustLCM(-4, 6) = %d, want 12", got)
+	}
+	if g, x, y := safemath.MustExtendedGCD(240, 46); g != 2 || 240*x+46*y != 2 {
+		t.Errorf("MustExtendedGCD(240, 46) = %d, %d, %d", g, x, y)
+	}
+	if got := safemath.MustModInverse(3, 11); got != 4 {
+		t.Errorf("MustModInverse(3, 11) = %d, want 4", got)
+	}
+
+	for name, fn := range map[string]func(){
+		"MustGCD":         func() { safemath.MustGCD(int8(math.MinInt8), 0) },
+		"MustLCM":         func() { safemath.MustLCM(uint8(16), 17) },
+		"MustExtendedGCD": func() { safemath.MustExtendedGCD(int8(math.MinInt8), 0) },
+		"MustModInverse":  func() { safemath.MustModInverse(2, 4) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%s did not panic", name)
+				}
+			}()
+			fn()
+		})
+	}
+}
@@ -0,0 +1,514 @@
+package safemath
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+// Uint128 is an unsigned 128-bit integer, stored as its high and low 64-bit
+// halves. The zero value is 0.
+//
+// Uint128 values are comparable with == and !=; use [Uint128.Cmp] for
+// ordering.
+type Uint128 struct {
+	Hi, Lo uint64
+}
+
+// Int128 is a signed 128-bit integer in two's complement, stored as its high
+// and low 64-bit halves. The sign is carried by Hi. The zero value is 0.
+//
+// Int128 values are comparable with == and !=; use [Int128.Cmp] for ordering.
+type Int128 struct {
+	Hi int64
+	Lo uint64
+}
+
+// Uint128From returns v as a Uint128, or an error wrapping ErrTruncation if
+// v is negative.
+func Uint128From[T Integer](v T) (Uint128, error) {
+	if v < 0 {
+		return Uint128{}, unaryOpError[Uint128]("Uint128From", ErrTruncation, ErrNegativeOverflow, v)
+	}
+
+	return Uint128{Lo: uint64(v)}, nil
+}
+
+// Int128From returns v as an Int128. Every Integer type fits in Int128, so
+// the conversion never fails.
+func Int128From[T Integer](v T) Int128 {
+	// Converting to uint64 sign-extends signed values.
+	x := Int128{Lo: uint64(v)}
+	if v < 0 {
+		x.Hi = -1
+	}
+
+	return x
+}
+
+// ConvertUint128 safely converts v to the Integer type To, or returns an
+// error wrapping ErrTruncation if v cannot be represented.
+func ConvertUint128[To Integer](v Uint128) (To, error) {
+	c, ok := convert[To](v.Lo)
+	if v.Hi != 0 || !ok {
+		return 0, unaryOpError[To]("ConvertUint128", ErrTruncation, ErrPositiveOverflow, v)
+	}
+
+	return c, nil
+}
+
+// ConvertInt128 safely converts v to the Integer type To, or returns an
+// error wrapping ErrTruncation if v cannot be represented.
+func ConvertInt128[To Integer](v Int128) (To, error) {
+	c, ok := int128To[To](v)
+	if !ok {
+		return 0, unaryOpError[To]("ConvertInt128", ErrTruncation, direction(v.Hi < 0), v)
+	}
+
+	return c, nil
+}
+
+// Int128 returns x as an Int128, or ErrTruncation if x exceeds the largest
+// Int128.
+func (x Uint128) Int128() (Int128, error) {
+	if int64(x.Hi) < 0 {
+		return Int128{}, ErrTruncation
+	}
+
+	return Int128{Hi: int64(x.Hi), Lo: x.Lo}, nil
+}
+
+// Uint128 returns x as a Uint128, or ErrTruncation if x is negative.
+func (x Int128) Uint128() (Uint128, error) {
+	if x.Hi < 0 {
+		return Uint128{}, ErrTruncation
+	}
+
+	return Uint128{Hi: uint64(x.Hi), Lo: x.Lo}, nil
+}
+
+// Cmp compares x and y and returns -1 if x < y, 0 if x == y, and +1 if
+// x > y.
+func (x Uint128) Cmp(y Uint128) int {
+	switch {
+	case x == y:
+		return 0
+	case x.Hi < y.Hi || (x.Hi == y.Hi && x.Lo < y.Lo):
+		return -1
+	default:
+		return 1
+	}
+}
+
+// Add returns the sum of x and y, or an error if overflow occurs.
+func (x Uint128) Add(y Uint128) (Uint128, error) {
+	lo, carry := bits.Add64(x.Lo, y.Lo, 0)
+	hi, carry := bits.Add64(x.Hi, y.Hi, carry)
+	if carry != 0 {
+		return Uint128{}, ErrOverflow
+	}
+
+	return Uint128{Hi: hi, Lo: lo}, nil
+}
+
+// Sub returns the difference of x and y, or an error if overflow occurs.
+func (x Uint128) Sub(y Uint128) (Uint128, error) {
+	lo, borrow := bits.Sub64(x.Lo, y.Lo, 0)
+	hi, borrow := bits.Sub64(x.Hi, y.Hi, borrow)
+	if borrow != 0 {
+		return Uint128{}, ErrOverflow
+	}
+
+	return Uint128{Hi: hi, Lo: lo}, nil
+}
+
+// Mul returns the product of x and y, or an error if overflow occurs.
+func (x Uint128) Mul(y Uint128) (Uint128, error) {
+	// If both high halves are set, the product needs at least 128 bits.
+	if x.Hi != 0 && y.Hi != 0 {
+		return Uint128{}, ErrOverflow
+	}
+
+	hi, lo := bits.Mul64(x.Lo, y.Lo)
+
+	// At most one of the cross products is non-zero, and it must fit in the
+	// high half.
+	ch, cl := bits.Mul64(x.Hi, y.Lo)
+	if ch != 0 {
+		return Uint128{}, ErrOverflow
+	}
+
+	hi, carry := bits.Add64(hi, cl, 0)
+	if carry != 0 {
+		return Uint128{}, ErrOverflow
+	}
+
+	ch, cl = bits.Mul64(x.Lo, y.Hi)
+	if ch != 0 {
+		return Uint128{}, ErrOverflow
+	}
+
+	hi, carry = bits.Add64(hi, cl, 0)
+	if carry != 0 {
+		return Uint128{}, ErrOverflow
+	}
+
+	return Uint128{Hi: hi, Lo: lo}, nil
+}
+
+// Div returns the quotient of x and y.
+func (x Uint128) Div(y Uint128) (Uint128, error) {
+	if y == (Uint128{}) {
+		return Uint128{}, ErrDivisionByZero
+	}
+
+	q, _ := x.quoRem(y)
+
+	return q, nil
+}
+
+// Rem returns the remainder of x divided by y.
+func (x Uint128) Rem(y Uint128) (Uint128, error) {
+	if y == (Uint128{}) {
+		return Uint128{}, ErrDivisionByZero
+	}
+
+	_, r := x.quoRem(y)
+
+	return r, nil
+}
+
+// Neg returns the negation of x, or an error if overflow occurs (i.e., x is
+// non-zero).
+func (x Uint128) Neg() (Uint128, error) {
+	if x != (Uint128{}) {
+		return Uint128{}, ErrOverflow
+	}
+
+	return x, nil
+}
+
+// String returns the base 10 representation of x.
+func (x Uint128) String() string {
+	return string(x.appendDecimal(nil))
+}
+
+// ParseUint128 parses s as a base 10 Uint128. An optional leading "+" sign
+// is permitted.
+//
+// It returns ErrOverflow if the value does not fit, and a
+// [*strconv.NumError] wrapping [strconv.ErrSyntax] if s is malformed.
+func ParseUint128(s string) (Uint128, error) {
+	digits := s
+	if len(digits) > 0 && digits[0] == '+' {
+		digits = digits[1:]
+	}
+
+	x, err := parseUint128(digits)
+	if err == strconv.ErrSyntax {
+		return Uint128{}, syntaxError("ParseUint128", s)
+	}
+
+	return x, err
+}
+
+// Sign returns -1 if x < 0, 0 if x == 0, and +1 if x > 0.
+func (x Int128) Sign() int {
+	switch {
+	case x.Hi < 0:
+		return -1
+	case x == (Int128{}):
+		return 0
+	default:
+		return 1
+	}
+}
+
+// Cmp compares x and y and returns -1 if x < y, 0 if x == y, and +1 if
+// x > y.
+func (x Int128) Cmp(y Int128) int {
+	switch {
+	case x == y:
+		return 0
+	case x.Hi < y.Hi || (x.Hi == y.Hi && x.Lo < y.Lo):
+		return -1
+	default:
+		return 1
+	}
+}
+
+// Add returns the sum of x and y, or an error if overflow occurs.
+func (x Int128) Add(y Int128) (Int128, error) {
+	lo, carry := bits.Add64(x.Lo, y.Lo, 0)
+	hi, _ := bits.Add64(uint64(x.Hi), uint64(y.Hi), carry)
+	c := Int128{Hi: int64(hi), Lo: lo}
+
+	// Signed overflow occurs if operands have the same sign and the result
+	// has a different sign.
+	if (x.Hi < 0) == (y.Hi < 0) && (c.Hi < 0) != (x.Hi < 0) {
+		return Int128{}, ErrOverflow
+	}
+
+	return c, nil
+}
+
+// Sub returns the difference of x and y, or an error if overflow occurs.
+func (x Int128) Sub(y Int128) (Int128, error) {
+	lo, borrow := bits.Sub64(x.Lo, y.Lo, 0)
+	hi, _ := bits.Sub64(uint64(x.Hi), uint64(y.Hi), borrow)
+	c := Int128{Hi: int64(hi), Lo: lo}
+
+	// Signed overflow occurs if operands have different signs and the
+	// result has a different sign than x.
+	if (x.Hi < 0) != (y.Hi < 0) && (c.Hi < 0) != (x.Hi < 0) {
+		return Int128{}, ErrOverflow
+	}
+
+	return c, nil
+}
+
+// Mul returns the product of x and y, or an error if overflow occurs.
+func (x Int128) Mul(y Int128) (Int128, error) {
+	m, err := x.abs().Mul(y.abs())
+	if err != nil {
+		return Int128{}, ErrOverflow
+	}
+
+	return fromMagnitude(m, (x.Hi < 0) != (y.Hi < 0))
+}
+
+// Div returns the quotient of x and y, truncated toward zero.
+func (x Int128) Div(y Int128) (Int128, error) {
+	if y == (Int128{}) {
+		return Int128{}, ErrDivisionByZero
+	}
+
+	// Signed overflow: MinInt128 / -1 is covered by fromMagnitude, since the
+	// quotient magnitude is 2^127 with a positive sign.
+	q, _ := x.abs().quoRem(y.abs())
+
+	return fromMagnitude(q, (x.Hi < 0) != (y.Hi < 0))
+}
+
+// Rem returns the remainder of x divided by y, truncated toward zero.
+//
+// Because the corresponding quotient overflows, MinInt128 % -1 is rejected
+// with ErrOverflow, consistently with [Int128.Div].
+func (x Int128) Rem(y Int128) (Int128, error) {
+	if y == (Int128{}) {
+		return Int128{}, ErrDivisionByZero
+	}
+
+	if y == (Int128{Hi: -1, Lo: ^uint64(0)}) && x == minInt128 {
+		return Int128{}, ErrOverflow
+	}
+
+	_, r := x.abs().quoRem(y.abs())
+
+	return fromMagnitude(r, x.Hi < 0)
+}
+
+// Neg returns the negation of x, or an error if overflow occurs.
+func (x Int128) Neg() (Int128, error) {
+	if x == minInt128 {
+		return Int128{}, ErrOverflow
+	}
+
+	hi, lo := neg128(uint64(x.Hi), x.Lo)
+
+	return Int128{Hi: int64(hi), Lo: lo}, nil
+}
+
+// String returns the base 10 representation of x.
+func (x Int128) String() string {
+	if x.Hi >= 0 {
+		return string(Uint128{Hi: uint64(x.Hi), Lo: x.Lo}.appendDecimal(nil))
+	}
+
+	return string(x.abs().appendDecimal([]byte{'-'}))
+}
+
+// ParseInt128 parses s as a base 10 Int128. An optional leading "+" or "-"
+// sign is permitted.
+//
+// It returns ErrOverflow if the value does not fit, and a
+// [*strconv.NumError] wrapping [strconv.ErrSyntax] if s is malformed.
+func ParseInt128(s string) (Int128, error) {
+	digits, neg := s, false
+	if len(digits) > 0 && (digits[0] == '+' || digits[0] == '-') {
+		digits, neg = digits[1:], digits[0] == '-'
+	}
+
+	m, err := parseUint128(digits)
+	if err == strconv.ErrSyntax {
+		return Int128{}, syntaxError("ParseInt128", s)
+	}
+	if err != nil {
+		return Int128{}, err
+	}
+
+	return fromMagnitude(m, neg)
+}
+
+// int128To converts x to T, reporting whether it fits.
+func int128To[T Integer](x Int128) (T, bool) {
+	switch {
+	case x.Hi == 0:
+		v := T(x.Lo)
+
+		return v, uint64(v) == x.Lo && v >= 0
+	case x.Hi == -1 && int64(x.Lo) < 0:
+		v := T(int64(x.Lo))
+
+		return v, int64(v) == int64(x.Lo) && v < 0
+	default:
+		return 0, false
+	}
+}
+
+// minInt128 is the smallest value representable by Int128.
+var minInt128 = Int128{Hi: -1 << 63}
+
+// abs returns the magnitude of x. Unlike [Abs], it cannot overflow, since
+// |MinInt128| fits in a Uint128.
+func (x Int128) abs() Uint128 {
+	if x.Hi >= 0 {
+		return Uint128{Hi: uint64(x.Hi), Lo: x.Lo}
+	}
+
+	hi, lo := neg128(uint64(x.Hi), x.Lo)
+
+	return Uint128{Hi: hi, Lo: lo}
+}
+
+// fromMagnitude returns the Int128 with magnitude m, negated if neg is true,
+// or ErrOverflow if it does not fit.
+func fromMagnitude(m Uint128, neg bool) (Int128, error) {
+	limit := uint64(1) << 63
+	if m.Hi > limit || (m.Hi == limit && (!neg || m.Lo != 0)) {
+		return Int128{}, ErrOverflow
+	}
+
+	if neg {
+		m.Hi, m.Lo = neg128(m.Hi, m.Lo)
+	}
+
+	return Int128{Hi: int64(m.Hi), Lo: m.Lo}, nil
+}
+
+// quoRem returns the quotient and remainder of x divided by y, which must be
+// non-zero.
+func (x Uint128) quoRem(y Uint128) (q, r Uint128) {
+	if y.Hi == 0 {
+		var r64 uint64
+		q, r64 = x.quoRem64(y.Lo)
+
+		return q, Uint128{Lo: r64}
+	}
+
+	// Estimate the quotient from the normalized top 64 bits of y, which is
+	// either exact or one too large (see Hacker's Delight, 9-5).
+	n := uint(bits.LeadingZeros64(y.Hi))
+	y1 := y.shl(n)
+	x1 := x.shr(1)
+	tq, _ := bits.Div64(x1.Hi, x1.Lo, y1.Hi)
+	tq >>= 63 - n
+	if tq != 0 {
+		tq--
+	}
+
+	q = Uint128{Lo: tq}
+	// y * tq cannot overflow, since tq <= x / y.
+	yq, _ := y.Mul(q)
+	r, _ = x.Sub(yq)
+	if r.Cmp(y) >= 0 {
+		q, _ = q.Add(Uint128{Lo: 1})
+		r, _ = r.Sub(y)
+	}
+
+	return q, r
+}
+
+// quoRem64 returns the quotient and remainder of x divided by the non-zero
+// y.
+func (x Uint128) quoRem64(y uint64) (q Uint128, r uint64) {
+	if x.Hi < y {
+		q.Lo, r = bits.Div64(x.Hi, x.Lo, y)
+
+		return q, r
+	}
+
+	q.Hi, r = x.Hi/y, x.Hi%y
+	q.Lo, r = bits.Div64(r, x.Lo, y)
+
+	return q, r
+}
+
+// shl returns x shifted left by n < 64 bits.
+func (x Uint128) shl(n uint) Uint128 {
+	return Uint128{Hi: x.Hi<<n | x.Lo>>(64-n), Lo: x.Lo << n}
+}
+
+// shr returns x shifted right by n < 64 bits.
+func (x Uint128) shr(n uint) Uint128 {
+	return Uint128{Hi: x.Hi >> n, Lo: x.Lo>>n | x.Hi<<(64-n)}
+}
+
+// appendDecimal appends the base 10 representation of x to dst.
+func (x Uint128) appendDecimal(dst []byte) []byte {
+	if x.Hi == 0 {
+		return strconv.AppendUint(dst, x.Lo, 10)
+	}
+
+	// Split off the lowest 19 digits, the most that fit in a uint64.
+	const base = 1e19
+	q, r := x.quoRem64(base)
+	dst = q.appendDecimal(dst)
+	digits := strconv.FormatUint(r, 10)
+	for i := len(digits); i < 19; i++ {
+		dst = append(dst, '0')
+	}
+
+	return append(dst, digits...)
+}
+
+// parseUint128 parses the unsigned base 10 digits in s. It returns
+// strconv.ErrSyntax if s is empty or contains a non-digit, and ErrOverflow
+// if the value does not fit.
+func parseUint128(s string) (Uint128, error) {
+	if s == "" {
+		return Uint128{}, strconv.ErrSyntax
+	}
+
+	var (
+		x   Uint128
+		err error
+	)
+
+	ten := Uint128{Lo: 10}
+	for i := 0; i < len(s); i++ {
+		d := s[i] - '0'
+		if d > 9 {
+			return Uint128{}, strconv.ErrSyntax
+		}
+
+		// Keep scanning after overflow so syntax errors take precedence.
+		if err != nil {
+			continue
+		}
+
+		if x, err = x.Mul(ten); err == nil {
+			x, err = x.Add(Uint128{Lo: uint64(d)})
+		}
+	}
+
+	if err != nil {
+		return Uint128{}, err
+	}
+
+	return x, nil
+}
+
+// syntaxError returns a *strconv.NumError reporting that str is malformed.
+func syntaxError(fn, str string) error {
+	return &strconv.NumError{Func: fn, Num: str, Err: strconv.ErrSyntax}
+}
@@ -0,0 +1,431 @@
+package safemath_test
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+
+	"go.dw1.io/safemath"
+)
+
+var (
+	maxUint128 = safemath.Uint128{Hi: math.MaxUint64, Lo: math.MaxUint64}
+	maxInt128  = safemath.Int128{Hi: math.MaxInt64, Lo: math.MaxUint64}
+	minInt128  = safemath.Int128{Hi: math.MinInt64}
+	minusOne   = safemath.Int128{Hi: -1, Lo: math.MaxUint64}
+)
+
+func TestUint128(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() (safemath.Uint128, error)
+		want      safemath.Uint128
+		wantError error
+	}{
+		{
+			name: "Add carry",
+			fn: func() (safemath.Uint128, error) {
+				return safemath.Uint128{Lo: math.MaxUint64}.Add(safemath.Uint128{Lo: 1})
+			},
+			want: safemath.Uint128{Hi: 1},
+		},
+		{
+			name:      "Add overflow",
+			fn:        func() (safemath.Uint128, error) { return maxUint128.Add(safemath.Uint128{Lo: 1}) },
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "Sub borrow",
+			fn: func() (safemath.Uint128, error) {
+				return safemath.Uint128{Hi: 1}.Sub(safemath.Uint128{Lo: 1})
+			},
+			want: safemath.Uint128{Lo: math.MaxUint64},
+		},
+		{
+			name:      "Sub underflow",
+			fn:        func() (safemath.Uint128, error) { return safemath.Uint128{}.Sub(safemath.Uint128{Lo: 1}) },
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "Mul 64x64",
+			fn: func() (safemath.Uint128, error) {
+				return safemath.Uint128{Lo: math.MaxUint64}.Mul(safemath.Uint128{Lo: math.MaxUint64})
+			},
+			want: safemath.Uint128{Hi: math.MaxUint64 - 1, Lo: 1},
+		},
+		{
+			name: "Mul overflow",
+			fn: func() (safemath.Uint128, error) {
+				return safemath.Uint128{Hi: 1}.Mul(safemath.Uint128{Hi: 1})
+			},
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "Mul cross overflow",
+			fn: func() (safemath.Uint128, error) {
+				return safemath.Uint128{Hi: 1 << 63}.Mul(safemath.Uint128{Lo: 2})
+			},
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name:      "Div by zero",
+			fn:        func() (safemath.Uint128, error) { return maxUint128.Div(safemath.Uint128{}) },
+			wantError: safemath.ErrDivisionByZero,
+		},
+		{
+			name: "Div wide divisor",
+			fn: func() (safemath.Uint128, error) {
+				return maxUint128.Div(safemath.Uint128{Hi: 1})
+			},
+			want: safemath.Uint128{Lo: math.MaxUint64},
+		},
+		{
+			name: "Rem wide divisor",
+			fn: func() (safemath.Uint128, error) {
+				return maxUint128.Rem(safemath.Uint128{Hi: 1})
+			},
+			want: safemath.Uint128{Lo: math.MaxUint64},
+		},
+		{
+			name:      "Neg non-zero",
+			fn:        func() (safemath.Uint128, error) { return safemath.Uint128{Lo: 1}.Neg() },
+			wantError: safemath.ErrOverflow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if err != tt.wantError {
+				t.Fatalf("got error %v, want %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt128(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() (safemath.Int128, error)
+		want      safemath.Int128
+		wantError error
+	}{
+		{
+			name:      "Add overflow",
+			fn:        func() (safemath.Int128, error) { return maxInt128.Add(safemath.Int128From(1)) },
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name:      "Add underflow",
+			fn:        func() (safemath.Int128, error) { return minInt128.Add(minusOne) },
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "Add mixed signs",
+			fn:   func() (safemath.Int128, error) { return minInt128.Add(maxInt128) },
+			want: minusOne,
+		},
+		{
+			name:      "Sub underflow",
+			fn:        func() (safemath.Int128, error) { return minInt128.Sub(safemath.Int128From(1)) },
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name:      "Sub overflow",
+			fn:        func() (safemath.Int128, error) { return maxInt128.Sub(minusOne) },
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "Mul negative",
+			fn: func() (safemath.Int128, error) {
+				return safemath.Int128From(int64(math.MinInt64)).Mul(safemath.Int128From(uint64(1 << 63)))
+			},
+			want: safemath.Int128{Hi: math.MinInt64 >> 1},
+		},
+		{
+			name:      "Mul min * -1",
+			fn:        func() (safemath.Int128, error) { return minInt128.Mul(minusOne) },
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "Mul min * 1",
+			fn:   func() (safemath.Int128, error) { return minInt128.Mul(safemath.Int128From(1)) },
+			want: minInt128,
+		},
+		{
+			name:      "Div by zero",
+			fn:        func() (safemath.Int128, error) { return maxInt128.Div(safemath.Int128{}) },
+			wantError: safemath.ErrDivisionByZero,
+		},
+		{
+			name:      "Div min / -1",
+			fn:        func() (safemath.Int128, error) { return minInt128.Div(minusOne) },
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "Div truncates toward zero",
+			fn:   func() (safemath.Int128, error) { return safemath.Int128From(-7).Div(safemath.Int128From(2)) },
+			want: safemath.Int128From(-3),
+		},
+		{
+			name:      "Rem min % -1",
+			fn:        func() (safemath.Int128, error) { return minInt128.Rem(minusOne) },
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "Rem sign follows dividend",
+			fn:   func() (safemath.Int128, error) { return safemath.Int128From(-7).Rem(safemath.Int128From(-2)) },
+			want: safemath.Int128From(-1),
+		},
+		{
+			name:      "Neg min",
+			fn:        func() (safemath.Int128, error) { return minInt128.Neg() },
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "Neg max",
+			fn:   func() (safemath.Int128, error) { return maxInt128.Neg() },
+			want: safemath.Int128{Hi: math.MinInt64, Lo: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if err != tt.wantError {
+				t.Fatalf("got error %v, want %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt128Cmp(t *testing.T) {
+	ordered := []safemath.Int128{
+		minInt128,
+		safemath.Int128From(int64(math.MinInt64)),
+		minusOne,
+		{},
+		safemath.Int128From(1),
+		safemath.Int128From(uint64(math.MaxUint64)),
+		maxInt128,
+	}
+
+	for i, x := range ordered {
+		for j, y := range ordered {
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+			if got := x.Cmp(y); got != want {
+				t.Errorf("%v.Cmp(%v) = %d; want %d", x, y, got, want)
+			}
+		}
+	}
+
+	if minusOne.Sign() != -1 || (safemath.Int128{}).Sign() != 0 || maxInt128.Sign() != 1 {
+		t.Error("Sign returned unexpected result")
+	}
+
+	if (safemath.Uint128{Hi: 1}).Cmp(safemath.Uint128{Lo: math.MaxUint64}) != 1 {
+		t.Error("Uint128.Cmp returned unexpected result")
+	}
+}
+
+func TestInt128String(t *testing.T) {
+	tests := []struct {
+		str string
+		v   safemath.Int128
+	}{
+		{str: "0", v: safemath.Int128{}},
+		{str: "-1", v: minusOne},
+		{str: "18446744073709551616", v: safemath.Int128{Hi: 1}},
+		{str: "10000000000000000000000000000000000000", v: safemath.Int128{Hi: 542101086242752217, Lo: 68739955140067328}},
+		{str: "170141183460469231731687303715884105727", v: maxInt128},
+		{str: "-170141183460469231731687303715884105728", v: minInt128},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.str, func(t *testing.T) {
+			if got := tt.v.String(); got != tt.str {
+				t.Errorf("String() = %q; want %q", got, tt.str)
+			}
+
+			got, err := safemath.ParseInt128(tt.str)
+			if err != nil || got != tt.v {
+				t.Errorf("ParseInt128(%q) = %v, %v; want %v", tt.str, got, err, tt.v)
+			}
+		})
+	}
+
+	if got := maxUint128.String(); got != "340282366920938463463374607431768211455" {
+		t.Errorf("Uint128 String() = %q", got)
+	}
+}
+
+func TestParse128Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() error
+		wantError error
+	}{
+		{
+			name: "Int128 overflow",
+			fn: func() error {
+				_, err := safemath.ParseInt128("170141183460469231731687303715884105728")
+				return err
+			},
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "Int128 underflow",
+			fn: func() error {
+				_, err := safemath.ParseInt128("-170141183460469231731687303715884105729")
+				return err
+			},
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "Uint128 overflow",
+			fn: func() error {
+				_, err := safemath.ParseUint128("340282366920938463463374607431768211456")
+				return err
+			},
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "Uint128 negative",
+			fn: func() error {
+				_, err := safemath.ParseUint128("-1")
+				return err
+			},
+			wantError: strconv.ErrSyntax,
+		},
+		{
+			name: "Int128 empty",
+			fn: func() error {
+				_, err := safemath.ParseInt128("-")
+				return err
+			},
+			wantError: strconv.ErrSyntax,
+		},
+		{
+			name: "Int128 invalid digit",
+			fn: func() error {
+				_, err := safemath.ParseInt128("999999999999999999999999999999999999999999x")
+				return err
+			},
+			wantError: strconv.ErrSyntax,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.fn(); !errors.Is(err, tt.wantError) {
+				t.Errorf("got error %v, want %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestConvert128(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() (any, error)
+		want      any
+		wantError error
+	}{
+		{
+			name: "Int128From negative",
+			fn:   func() (any, error) { return safemath.Int128From[int8](-1), nil },
+			want: minusOne,
+		},
+		{
+			name: "Int128From uint64",
+			fn:   func() (any, error) { return safemath.Int128From(uint64(math.MaxUint64)), nil },
+			want: safemath.Int128{Lo: math.MaxUint64},
+		},
+		{
+			name:      "Uint128From negative",
+			fn:        func() (any, error) { return safemath.Uint128From(-1) },
+			want:      safemath.Uint128{},
+			wantError: safemath.ErrNegativeOverflow,
+		},
+		{
+			name: "ConvertInt128 to int8",
+			fn:   func() (any, error) { return safemath.ConvertInt128[int8](safemath.Int128From(-128)) },
+			want: int8(-128),
+		},
+		{
+			name:      "ConvertInt128 to int8 truncation",
+			fn:        func() (any, error) { return safemath.ConvertInt128[int8](safemath.Int128From(128)) },
+			want:      int8(0),
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name: "ConvertInt128 to uint64",
+			fn:   func() (any, error) { return safemath.ConvertInt128[uint64](safemath.Int128{Lo: math.MaxUint64}) },
+			want: uint64(math.MaxUint64),
+		},
+		{
+			name:      "ConvertInt128 negative to uint64",
+			fn:        func() (any, error) { return safemath.ConvertInt128[uint64](minusOne) },
+			want:      uint64(0),
+			wantError: safemath.ErrNegativeOverflow,
+		},
+		{
+			name:      "ConvertInt128 wide to int64",
+			fn:        func() (any, error) { return safemath.ConvertInt128[int64](minInt128) },
+			want:      int64(0),
+			wantError: safemath.ErrNegativeOverflow,
+		},
+		{
+			name:      "ConvertUint128 wide to uint64",
+			fn:        func() (any, error) { return safemath.ConvertUint128[uint64](safemath.Uint128{Hi: 1}) },
+			want:      uint64(0),
+			wantError: safemath.ErrPositiveOverflow,
+		},
+		{
+			name: "ConvertUint128 to uint16",
+			fn:   func() (any, error) { return safemath.ConvertUint128[uint16](safemath.Uint128{Lo: 65535}) },
+			want: uint16(65535),
+		},
+		{
+			name:      "Uint128 to Int128 truncation",
+			fn:        func() (any, error) { return maxUint128.Int128() },
+			want:      safemath.Int128{},
+			wantError: safemath.ErrTruncation,
+		},
+		{
+			name:      "Int128 to Uint128 truncation",
+			fn:        func() (any, error) { return minusOne.Uint128() },
+			want:      safemath.Uint128{},
+			wantError: safemath.ErrTruncation,
+		},
+		{
+			name: "Int128 to Uint128",
+			fn:   func() (any, error) { return maxInt128.Uint128() },
+			want: safemath.Uint128{Hi: math.MaxInt64, Lo: math.MaxUint64},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("got error %v, want %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package safemath
+
+import "math/bits"
+
+// pow10tab holds the powers of 10 representable in a uint64.
+var pow10tab = [...]uint64{
+	1e00, 1e01, 1e02, 1e03, 1e04, 1e05, 1e06, 1e07, 1e08, 1e09,
+	1e10, 1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18, 1e19,
+}
+
+// ILog2 returns the base 2 logarithm of x, rounded down, i.e. the index of
+// its most significant set bit. It returns ErrDomain if x is not positive.
+func ILog2[T Integer](x T) (uint, error) {
+	if x <= 0 {
+		return 0, unaryOpError[T]("ILog2", ErrDomain, nil, x)
+	}
+
+	return uint(bits.Len64(uint64(x))) - 1, nil
+}
+
+// ILog10 returns the base 10 logarithm of x, rounded down, i.e. one less
+// than its number of decimal digits. It returns ErrDomain if x is not
+// positive.
+func ILog10[T Integer](x T) (uint, error) {
+	if x <= 0 {
+		return 0, unaryOpError[T]("ILog10", ErrDomain, nil, x)
+	}
+
+	// Estimate from the bit length (1233/4096 approximates log10(2)); the
+	// estimate is exact or one too large.
+	u := uint64(x)
+	t := uint(bits.Len64(u)) * 1233 >> 12
+	if u < pow10tab[t] {
+		t--
+	}
+
+	return t, nil
+}
+
+// ILog returns the logarithm of x in the given base, rounded down, i.e. the
+// largest n such that base^n <= x.
+//
+// It returns ErrInvalidBase if base is less than 2, and ErrDomain if x is
+// not positive.
+func ILog[T Integer](x, base T) (uint, error) {
+	if base < 2 {
+		return 0, binaryOpError("ILog", ErrInvalidBase, nil, x, base)
+	}
+
+	if x <= 0 {
+		return 0, binaryOpError("ILog", ErrDomain, nil, x, base)
+	}
+
+	var n uint
+	for x >= base {
+		x /= base
+		n++
+	}
+
+	return n, nil
+}
+
+// MustILog2 returns the base 2 logarithm of x on success. Panics on error.
+func MustILog2[T Integer](x T) uint {
+	c, err := ILog2(x)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustILog10 returns the base 10 logarithm of x on success. Panics on error.
+func MustILog10[T Integer](x T) uint {
+	c, err := ILog10(x)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustILog returns the logarithm of x in the given base on success. Panics
+// on error.
+func MustILog[T Integer](x, base T) uint {
+	c, err := ILog(x, base)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
@@ -0,0 +1,153 @@
+package safemath_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"go.dw1.io/safemath"
+)
+
+func TestILogExhaustive16(t *testing.T) {
+	for base := 2; base <= 40; base++ {
+		n, p := uint(0), base
+		for x := 1; x <= math.MaxUint16; x++ {
+			if x == p {
+				n++
+				p *= base
+			}
+
+			got, err := safemath.ILog(uint16(x), uint16(base))
+			if err != nil || got != n {
+				t.Fatalf("ILog[uint16](%d, %d) = %d, %v; want %d", x, base, got, err, n)
+			}
+
+			if base == 2 {
+				if got, err := safemath.ILog2(uint16(x)); err != nil || got != n {
+					t.Fatalf("ILog2[uint16](%d) = %d, %v; want %d", x, got, err, n)
+				}
+			}
+			if base == 10 {
+				if got, err := safemath.ILog10(uint16(x)); err != nil || got != n {
+					t.Fatalf("ILog10[uint16](%d) = %d, %v; want %d", x, got, err, n)
+				}
+			}
+		}
+	}
+}
+
+func TestILog10Powers(t *testing.T) {
+	p := uint64(1)
+	for n := uint(0); n <= 19; n++ {
+		if got, _ := safemath.ILog10(p); got != n {
+			t.Errorf("ILog10(%d) = %d; want %d", p, got, n)
+		}
+		if got, _ := safemath.ILog10(p - 1); p > 1 && got != n-1 {
+			t.Errorf("ILog10(%d) = %d; want %d", p-1, got, n-1)
+		}
+		if n < 19 {
+			p *= 10
+		}
+	}
+
+	if got, _ := safemath.ILog10(uint64(math.MaxUint64)); got != 19 {
+		t.Errorf("ILog10(MaxUint64) = %d; want 19", got)
+	}
+	if got, _ := safemath.ILog10(int64(math.MaxInt64)); got != 18 {
+		t.Errorf("ILog10(MaxInt64) = %d; want 18", got)
+	}
+}
+
+func TestILog(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() (uint, error)
+		want      uint
+		wantError error
+	}{
+		{
+			name: "ILog2 uint64 max",
+			fn:   func() (uint, error) { return safemath.ILog2(uint64(math.MaxUint64)) },
+			want: 63,
+		},
+		{
+			name: "ILog2 int8 max",
+			fn:   func() (uint, error) { return safemath.ILog2(int8(math.MaxInt8)) },
+			want: 6,
+		},
+		{
+			name:      "ILog2 zero",
+			fn:        func() (uint, error) { return safemath.ILog2(0) },
+			wantError: safemath.ErrDomain,
+		},
+		{
+			name:      "ILog10 negative",
+			fn:        func() (uint, error) { return safemath.ILog10(int64(math.MinInt64)) },
+			wantError: safemath.ErrDomain,
+		},
+		{
+			name: "ILog uint64 max base 3",
+			fn:   func() (uint, error) { return safemath.ILog(uint64(math.MaxUint64), 3) },
+			want: 40,
+		},
+		{
+			name: "ILog base larger than x",
+			fn:   func() (uint, error) { return safemath.ILog(int8(100), 127) },
+			want: 0,
+		},
+		{
+			name:      "ILog base 1",
+			fn:        func() (uint, error) { return safemath.ILog(100, 1) },
+			wantError: safemath.ErrInvalidBase,
+		},
+		{
+			name:      "ILog negative base",
+			fn:        func() (uint, error) { return safemath.ILog(100, -10) },
+			wantError: safemath.ErrInvalidBase,
+		},
+		{
+			name:      "ILog negative",
+			fn:        func() (uint, error) { return safemath.ILog(-100, 10) },
+			wantError: safemath.ErrDomain,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("got error %v, want %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMustILog(t *testing.T) {
+	if got := safemath.MustILog2(1024); got != 10 {
+		t.Errorf("MustILog2(1024) = %d, want 10", got)
+	}
+	if got := safemath.MustILog10(999); got != 2 {
+		t.Errorf("MustILog10(999) = %d, want 2", got)
+	}
+	if got := safemath.MustILog(81, 3); got != 4 {
+		t.Errorf("MustILog(81, 3) = %d, want 4", got)
+	}
+
+	for name, fn := range map[string]func(){
+		"MustILog2":  func() { safemath.MustILog2(-1) },
+		"MustILog10": func() { safemath.MustILog10(0) },
+		"MustILog":   func() { safemath.MustILog(8, 0) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%s did not panic", name)
+				}
+			}()
+			fn()
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package safemath
+
+import "math/bits"
+
+// Midpoint returns the average of a and b, rounded toward negative infinity.
+// Unlike (a+b)/2, it never overflows.
+func Midpoint[T Integer](a, b T) T {
+	// The common bits plus half of the differing bits. For signed types the
+	// arithmetic shift rounds toward negative infinity.
+	return (a & b) + (a^b)>>1
+}
+
+// Mean returns the average of xs, rounded according to mode. The sum is
+// accumulated in 128 bits, so Mean never overflows; the average of an empty
+// slice is undefined and reported as ErrDivisionByZero.
+func Mean[T Integer](xs []T, mode RoundingMode) (T, error) {
+	if len(xs) == 0 {
+		return 0, unaryOpError[T]("Mean", ErrDivisionByZero, nil, xs)
+	}
+
+	sum := sum128(xs)
+	neg := sum.Hi < 0
+	m := sum.abs()
+
+	// Every element is below 2^64 in magnitude, so m < n * 2^64, i.e.
+	// m.Hi < n, and the quotient fits in 64 bits. Div64 panics otherwise.
+	n := uint64(len(xs))
+	q, rem := bits.Div64(m.Hi, m.Lo, n)
+	if roundAway(mode, rem, n, neg, q&1 == 1) {
+		q++
+	}
+
+	// The mean lies between the smallest and largest element, and so does its
+	// rounding, hence the conversion below is exact.
+	v := T(q)
+	if neg {
+		v = -v
+	}
+
+	return v, nil
+}
+
+// MustMean returns the average of xs on success. Panics on error.
+func MustMean[T Integer](xs []T, mode RoundingMode) T {
+	c, err := Mean(xs, mode)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
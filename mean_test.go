@@ -0,0 +1,151 @@
+package safemath_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"go.dw1.io/safemath"
+)
+
+func TestMidpoint(t *testing.T) {
+	// Exhaustive check of the 8-bit types against the exact floored average.
+	for a := math.MinInt8; a <= math.MaxInt8; a++ {
+		for b := math.MinInt8; b <= math.MaxInt8; b++ {
+			want := int(math.Floor(float64(a+b) / 2))
+			if got := safemath.Midpoint(int8(a), int8(b)); int(got) != want {
+				t.Fatalf("Midpoint[int8](%d, %d) = %d; want %d", a, b, got, want)
+			}
+		}
+	}
+
+	for a := 0; a <= math.MaxUint8; a++ {
+		for b := 0; b <= math.MaxUint8; b++ {
+			want := (a + b) / 2
+			if got := safemath.Midpoint(uint8(a), uint8(b)); int(got) != want {
+				t.Fatalf("Midpoint[uint8](%d, %d) = %d; want %d", a, b, got, want)
+			}
+		}
+	}
+
+	if got := safemath.Midpoint(int64(math.MaxInt64), int64(math.MaxInt64-2)); got != math.MaxInt64-1 {
+		t.Errorf("Midpoint(MaxInt64, MaxInt64-2) = %d", got)
+	}
+	if got := safemath.Midpoint(uint64(math.MaxUint64), uint64(math.MaxUint64)); got != math.MaxUint64 {
+		t.Errorf("Midpoint(MaxUint64, MaxUint64) = %d", got)
+	}
+}
+
+func TestMeanRounding(t *testing.T) {
+	inputs := [][]int{{-2, -3}, {-1, -2}, {-1, -1, -2}, {0, -1}, {0}, {0, 1}, {1, 1, 2}, {1, 2}, {1, 2, 2}, {2, 3}}
+	want := map[safemath.RoundingMode][]int{
+		safemath.RoundTruncate:         {-2, -1, -1, 0, 0, 0, 1, 1, 1, 2},
+		safemath.RoundFloor:            {-3, -2, -2, -1, 0, 0, 1, 1, 1, 2},
+		safemath.RoundCeil:             {-2, -1, -1, 0, 0, 1, 2, 2, 2, 3},
+		safemath.RoundHalfEven:         {-2, -2, -1, 0, 0, 0, 1, 2, 2, 2},
+		safemath.RoundHalfAwayFromZero: {-3, -2, -1, -1, 0, 1, 1, 2, 2, 3},
+	}
+
+	for mode, results := range want {
+		for i, xs := range inputs {
+			got, err := safemath.Mean(xs, mode)
+			if err != nil {
+				t.Errorf("Mean(%v, %d) error = %v", xs, mode, err)
+				continue
+			}
+			if got != results[i] {
+				t.Errorf("Mean(%v, %d) = %d; want %d", xs, mode, got, results[i])
+			}
+		}
+	}
+}
+
+func TestMean(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() (any, error)
+		want      any
+		wantError error
+	}{
+		{
+			name:      "empty",
+			fn:        func() (any, error) { return safemath.Mean([]int{}, safemath.RoundTruncate) },
+			want:      0,
+			wantError: safemath.ErrDivisionByZero,
+		},
+		{
+			name: "int64 max",
+			fn: func() (any, error) {
+				return safemath.Mean([]int64{math.MaxInt64, math.MaxInt64, math.MaxInt64}, safemath.RoundCeil)
+			},
+			want: int64(math.MaxInt64),
+		},
+		{
+			name: "int64 min",
+			fn: func() (any, error) {
+				return safemath.Mean([]int64{math.MinInt64, math.MinInt64}, safemath.RoundFloor)
+			},
+			want: int64(math.MinInt64),
+		},
+		{
+			name: "int64 min and max",
+			fn: func() (any, error) {
+				return safemath.Mean([]int64{math.MinInt64, math.MaxInt64}, safemath.RoundHalfAwayFromZero)
+			},
+			want: int64(-1),
+		},
+		{
+			name: "uint64 max",
+			fn: func() (any, error) {
+				return safemath.Mean([]uint64{math.MaxUint64, math.MaxUint64 - 1}, safemath.RoundHalfEven)
+			},
+			want: uint64(math.MaxUint64 - 1),
+		},
+		{
+			name: "uint64 all max",
+			fn: func() (any, error) {
+				return safemath.Mean([]uint64{math.MaxUint64, math.MaxUint64, math.MaxUint64}, safemath.RoundCeil)
+			},
+			want: uint64(math.MaxUint64),
+		},
+		{
+			name: "uint8",
+			fn:   func() (any, error) { return safemath.Mean([]uint8{255, 255, 1}, safemath.RoundTruncate) },
+			want: uint8(170),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("got error %v, want %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMustMean(t *testing.T) {
+	if got := safemath.MustMean([]int{1, 2}, safemath.RoundCeil); got != 2 {
+		t.Errorf("MustMean = %d, want 2", got)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("MustMean did not panic on an empty slice")
+		}
+	}()
+	safemath.MustMean([]int(nil), safemath.RoundTruncate)
+}
+
+func TestMeanInvalidMode(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Mean did not panic on invalid rounding mode")
+		}
+	}()
+	_, _ = safemath.Mean([]int{2, 4}, safemath.RoundingMode(100))
+}
@@ -0,0 +1,78 @@
+package safemath
+
+// OverflowingAdd returns the sum of a and b wrapped around in two's
+// complement, and whether overflow occurred.
+func OverflowingAdd[T Integer](a, b T) (T, bool) {
+	c := a + b
+	if isSigned[T]() {
+		// Signed overflow occurs if operands have the same sign and the result
+		// has a different sign.
+		return c, (a > 0 && b > 0 && c < 0) || (a < 0 && b < 0 && c > 0)
+	}
+
+	// Unsigned overflow occurs if the result is less than one of the
+	// operands. (c < a) implies overflow.
+	return c, c < a
+}
+
+// OverflowingSub returns the difference of a and b wrapped around in two's
+// complement, and whether overflow occurred.
+func OverflowingSub[T Integer](a, b T) (T, bool) {
+	c := a - b
+	if isSigned[T]() {
+		// Signed overflow occurs if operands have different signs and the
+		// result has a different sign than a.
+		// e.g., pos - neg = neg (Overflow)
+		//       neg - pos = pos (Underflow)
+		return c, (a > 0 && b < 0 && c < 0) || (a < 0 && b > 0 && c > 0)
+	}
+
+	// Unsigned overflow (underflow) occurs if a < b.
+	return c, a < b
+}
+
+// OverflowingMul returns the product of a and b wrapped around in two's
+// complement, and whether overflow occurred.
+func OverflowingMul[T Integer](a, b T) (T, bool) {
+	if a == 0 || b == 0 {
+		return 0, false
+	}
+
+	c := a * b
+
+	// Specific edge case for signed integers: MinInt * -1
+	// The problem is that c/a != b check relies on division. If a is -1 and c
+	// is MinInt, c/a panics on some archs (MinInt / -1). So we must check for
+	// -1 * MinInt (or vice versa) before doing the division check.
+	if isSigned[T]() {
+		minOne := ^T(0)
+		if (a == minOne && b == -b) || (b == minOne && a == -a) {
+			return c, true
+		}
+	}
+
+	// General overflow check (covers most cases).
+	return c, c/a != b
+}
+
+// OverflowingDiv returns the quotient of a and b wrapped around in two's
+// complement, and whether overflow occurred. The only overflowing case is
+// MinInt / -1, which wraps to MinInt.
+//
+// Division by zero has no wrapped result, so OverflowingDiv panics with an
+// error wrapping ErrDivisionByZero when b is zero.
+func OverflowingDiv[T Integer](a, b T) (T, bool) {
+	if b == 0 {
+		panic(binaryOpError("OverflowingDiv", ErrDivisionByZero, nil, a, b))
+	}
+
+	if isSigned[T]() {
+		minOne := ^T(0)
+		// Signed overflow: MinInt / -1
+		if b == minOne && a != 0 && a == -a {
+			return a, true
+		}
+	}
+
+	return a / b, false
+}
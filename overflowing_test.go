@@ -0,0 +1,97 @@
+package safemath_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"go.dw1.io/safemath"
+)
+
+func TestOverflowing(t *testing.T) {
+	tests := []struct {
+		name         string
+		fn           func() (any, bool)
+		want         any
+		wantOverflow bool
+	}{
+		{
+			name: "Add int8 overflow",
+			fn:   func() (any, bool) { return safemath.OverflowingAdd[int8](math.MaxInt8, 1) },
+			want: int8(math.MinInt8), wantOverflow: true,
+		},
+		{
+			name: "Add uint8 overflow",
+			fn:   func() (any, bool) { return safemath.OverflowingAdd[uint8](math.MaxUint8, 2) },
+			want: uint8(1), wantOverflow: true,
+		},
+		{
+			name: "Add int64 ok",
+			fn:   func() (any, bool) { return safemath.OverflowingAdd[int64](-1, 2) },
+			want: int64(1), wantOverflow: false,
+		},
+		{
+			name: "Sub int8 underflow",
+			fn:   func() (any, bool) { return safemath.OverflowingSub[int8](math.MinInt8, 1) },
+			want: int8(math.MaxInt8), wantOverflow: true,
+		},
+		{
+			name: "Sub uint32 underflow",
+			fn:   func() (any, bool) { return safemath.OverflowingSub[uint32](0, 1) },
+			want: uint32(math.MaxUint32), wantOverflow: true,
+		},
+		{
+			name: "Sub int ok",
+			fn:   func() (any, bool) { return safemath.OverflowingSub(1, 2) },
+			want: -1, wantOverflow: false,
+		},
+		{
+			name: "Mul int8 overflow",
+			fn:   func() (any, bool) { return safemath.OverflowingMul[int8](math.MaxInt8, 2) },
+			want: int8(-2), wantOverflow: true,
+		},
+		{
+			name: "Mul int64 min * -1",
+			fn:   func() (any, bool) { return safemath.OverflowingMul[int64](math.MinInt64, -1) },
+			want: int64(math.MinInt64), wantOverflow: true,
+		},
+		{
+			name: "Mul uint16 overflow",
+			fn:   func() (any, bool) { return safemath.OverflowingMul[uint16](0x100, 0x101) },
+			want: uint16(0x100), wantOverflow: true,
+		},
+		{
+			name: "Mul by zero",
+			fn:   func() (any, bool) { return safemath.OverflowingMul(0, -1) },
+			want: 0, wantOverflow: false,
+		},
+		{
+			name: "Div int8 min / -1",
+			fn:   func() (any, bool) { return safemath.OverflowingDiv[int8](math.MinInt8, -1) },
+			want: int8(math.MinInt8), wantOverflow: true,
+		},
+		{
+			name: "Div int ok",
+			fn:   func() (any, bool) { return safemath.OverflowingDiv(-7, 2) },
+			want: -3, wantOverflow: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, overflow := tt.fn()
+			if got != tt.want || overflow != tt.wantOverflow {
+				t.Errorf("got (%v, %v), want (%v, %v)", got, overflow, tt.want, tt.wantOverflow)
+			}
+		})
+	}
+}
+
+func TestOverflowingDivPanicsOnZero(t *testing.T) {
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, safemath.ErrDivisionByZero) {
+			t.Errorf("recovered %v, want %v", err, safemath.ErrDivisionByZero)
+		}
+	}()
+	safemath.OverflowingDiv(1, 0)
+}
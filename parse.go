@@ -0,0 +1,188 @@
+package safemath
+
+import "math/bits"
+
+// Parse interprets s in the given base (0, or 2 to 36) and returns the
+// corresponding value of the Integer type T, with bounds determined by T.
+//
+// Like [strconv.ParseInt], an optional leading "+" or "-" sign is accepted,
+// and base 0 enables Go integer literal syntax: the base is implied by the
+// prefix following the sign ("0b", "0o" or "0", "0x", and 10 otherwise), and
+// underscores may separate digits.
+//
+// It returns ErrSyntax if s is malformed, ErrInvalidBase for an unsupported
+// base, and ErrTruncation if the value does not fit in T. All errors are
+// reported as an [*OpError] carrying s.
+func Parse[T Integer](s string, base int) (T, error) {
+	v, err := parse[T](s, base)
+	if err != nil {
+		return 0, unaryOpError[T]("Parse", err, parseDirection(s, err), s)
+	}
+
+	return v, nil
+}
+
+// ParseBytes is like [Parse], but parses b directly without converting it
+// to a string.
+func ParseBytes[T Integer](b []byte, base int) (T, error) {
+	v, err := parse[T](b, base)
+	if err != nil {
+		return 0, unaryOpError[T]("ParseBytes", err, parseDirection(b, err), string(b))
+	}
+
+	return v, nil
+}
+
+// MustParse parses s into T on success. Panics on error.
+func MustParse[T Integer](s string, base int) T {
+	c, err := Parse[T](s, base)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustParseBytes parses b into T on success. Panics on error.
+func MustParseBytes[T Integer](b []byte, base int) T {
+	c, err := ParseBytes[T](b, base)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// parse implements Parse and ParseBytes, returning a bare sentinel error.
+func parse[T Integer, S ~string | ~[]byte](s S, base int) (T, error) {
+	if base != 0 && (base < 2 || base > 36) {
+		return 0, ErrInvalidBase
+	}
+
+	i, neg := 0, false
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		i, neg = 1, s[0] == '-'
+	}
+
+	// Detect the base prefix.
+	underscores := false
+	if base == 0 {
+		base, underscores = 10, true
+		if len(s) > i && s[i] == '0' {
+			switch {
+			case len(s) > i+2 && lower(s[i+1]) == 'b':
+				base, i = 2, i+2
+			case len(s) > i+2 && lower(s[i+1]) == 'o':
+				base, i = 8, i+2
+			case len(s) > i+2 && lower(s[i+1]) == 'x':
+				base, i = 16, i+2
+			default:
+				base = 8
+			}
+		}
+	}
+
+	if i == len(s) {
+		return 0, ErrSyntax
+	}
+
+	// Accumulate the magnitude, remembering whether it exceeded uint64 but
+	// scanning to the end so that syntax errors take precedence.
+	var (
+		mag      uint64
+		overflow bool
+		// Underscores must separate digits or follow a base prefix, so the
+		// previous character is considered a digit after a prefix.
+		prevDigit = i > 0 && s[i-1] != '+' && s[i-1] != '-'
+	)
+
+	for ; i < len(s); i++ {
+		c := s[i]
+		if c == '_' {
+			if !underscores || !prevDigit || i+1 == len(s) {
+				return 0, ErrSyntax
+			}
+			prevDigit = false
+
+			continue
+		}
+
+		d := digitVal(c)
+		if d >= base {
+			return 0, ErrSyntax
+		}
+		prevDigit = true
+
+		if overflow {
+			continue
+		}
+
+		hi, lo := mulAdd64(mag, uint64(base), uint64(d))
+		if hi != 0 {
+			overflow = true
+
+			continue
+		}
+		mag = lo
+	}
+
+	if overflow {
+		return 0, ErrTruncation
+	}
+
+	limit := uint64(maxOf[T]())
+	if neg {
+		if isSigned[T]() {
+			limit++
+		} else {
+			limit = 0
+		}
+	}
+
+	if mag > limit {
+		return 0, ErrTruncation
+	}
+
+	v := T(mag)
+	if neg {
+		v = -v
+	}
+
+	return v, nil
+}
+
+// parseDirection returns the direction of a parse error, which is only known
+// for out-of-range values.
+func parseDirection[S ~string | ~[]byte](s S, err error) error {
+	if err != ErrTruncation {
+		return nil
+	}
+
+	return direction(len(s) > 0 && s[0] == '-')
+}
+
+// digitVal returns the value of the digit c in bases up to 36, or 36 if c
+// is not a digit.
+func digitVal(c byte) int {
+	switch {
+	case '0' <= c && c <= '9':
+		return int(c - '0')
+	case 'a' <= lower(c) && lower(c) <= 'z':
+		return int(lower(c)-'a') + 10
+	default:
+		return 36
+	}
+}
+
+// lower returns the lowercase of the ASCII letter c.
+func lower(c byte) byte {
+	return c | ('x' - 'X')
+}
+
+// mulAdd64 returns the 128-bit result of x*y + z as high and low halves.
+func mulAdd64(x, y, z uint64) (hi, lo uint64) {
+	hi, lo = bits.Mul64(x, y)
+	lo, carry := bits.Add64(lo, z, 0)
+
+	return hi + carry, lo
+}
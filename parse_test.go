@@ -0,0 +1,106 @@
+package safemath_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"go.dw1.io/safemath"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() (any, error)
+		want      any
+		wantError error
+	}{
+		{name: "int16 decimal", fn: func() (any, error) { return safemath.Parse[int16]("-32768", 10) }, want: int16(math.MinInt16)},
+		{name: "int16 overflow", fn: func() (any, error) { return safemath.Parse[int16]("32768", 10) }, want: int16(0), wantError: safemath.ErrPositiveOverflow},
+		{name: "int16 underflow", fn: func() (any, error) { return safemath.Parse[int16]("-32769", 10) }, want: int16(0), wantError: safemath.ErrNegativeOverflow},
+		{name: "uint8 max", fn: func() (any, error) { return safemath.Parse[uint8]("+255", 10) }, want: uint8(255)},
+		{name: "uint8 overflow", fn: func() (any, error) { return safemath.Parse[uint8]("256", 10) }, want: uint8(0), wantError: safemath.ErrTruncation},
+		{name: "uint8 negative", fn: func() (any, error) { return safemath.Parse[uint8]("-1", 10) }, want: uint8(0), wantError: safemath.ErrNegativeOverflow},
+		{name: "uint8 negative zero", fn: func() (any, error) { return safemath.Parse[uint8]("-0", 10) }, want: uint8(0)},
+		{name: "uint64 max", fn: func() (any, error) { return safemath.Parse[uint64]("18446744073709551615", 10) }, want: uint64(math.MaxUint64)},
+		{name: "uint64 overflow", fn: func() (any, error) { return safemath.Parse[uint64]("18446744073709551616", 10) }, want: uint64(0), wantError: safemath.ErrTruncation},
+		{name: "int64 min", fn: func() (any, error) { return safemath.Parse[int64]("-9223372036854775808", 10) }, want: int64(math.MinInt64)},
+		{name: "int64 huge", fn: func() (any, error) { return safemath.Parse[int64]("-99999999999999999999999", 10) }, want: int64(0), wantError: safemath.ErrNegativeOverflow},
+		{name: "hex", fn: func() (any, error) { return safemath.Parse[uint32]("DeadBeef", 16) }, want: uint32(0xDEADBEEF)},
+		{name: "base 36", fn: func() (any, error) { return safemath.Parse[int]("zz", 36) }, want: 36*36 - 1},
+		{name: "base 0 hex", fn: func() (any, error) { return safemath.Parse[int32]("-0x_7FFF_FFFF", 0) }, want: int32(-math.MaxInt32)},
+		{name: "base 0 binary", fn: func() (any, error) { return safemath.Parse[uint8]("0b1111_0000", 0) }, want: uint8(0xF0)},
+		{name: "base 0 octal", fn: func() (any, error) { return safemath.Parse[int]("0o17", 0) }, want: 15},
+		{name: "base 0 legacy octal", fn: func() (any, error) { return safemath.Parse[int]("017", 0) }, want: 15},
+		{name: "base 0 zero", fn: func() (any, error) { return safemath.Parse[int]("0", 0) }, want: 0},
+		{name: "base 0 decimal", fn: func() (any, error) { return safemath.Parse[int]("1_000_000", 0) }, want: 1000000},
+		{name: "empty", fn: func() (any, error) { return safemath.Parse[int]("", 10) }, want: 0, wantError: safemath.ErrSyntax},
+		{name: "sign only", fn: func() (any, error) { return safemath.Parse[int]("-", 10) }, want: 0, wantError: safemath.ErrSyntax},
+		{name: "prefix only", fn: func() (any, error) { return safemath.Parse[int]("0x", 0) }, want: 0, wantError: safemath.ErrSyntax},
+		{name: "invalid digit", fn: func() (any, error) { return safemath.Parse[int]("12a", 10) }, want: 0, wantError: safemath.ErrSyntax},
+		{name: "digit beyond base", fn: func() (any, error) { return safemath.Parse[int]("102", 2) }, want: 0, wantError: safemath.ErrSyntax},
+		{name: "syntax after overflow", fn: func() (any, error) { return safemath.Parse[int8]("99999999999999999999999x", 10) }, want: int8(0), wantError: safemath.ErrSyntax},
+		{name: "underscore without base 0", fn: func() (any, error) { return safemath.Parse[int]("1_000", 10) }, want: 0, wantError: safemath.ErrSyntax},
+		{name: "leading underscore", fn: func() (any, error) { return safemath.Parse[int]("_1", 0) }, want: 0, wantError: safemath.ErrSyntax},
+		{name: "trailing underscore", fn: func() (any, error) { return safemath.Parse[int]("1_", 0) }, want: 0, wantError: safemath.ErrSyntax},
+		{name: "double underscore", fn: func() (any, error) { return safemath.Parse[int]("1__0", 0) }, want: 0, wantError: safemath.ErrSyntax},
+		{name: "invalid base", fn: func() (any, error) { return safemath.Parse[int]("1", 37) }, want: 0, wantError: safemath.ErrInvalidBase},
+		{name: "bytes", fn: func() (any, error) { return safemath.ParseBytes[int16]([]byte("-0x8000"), 0) }, want: int16(math.MinInt16)},
+		{name: "bytes overflow", fn: func() (any, error) { return safemath.ParseBytes[int16]([]byte("0x8000"), 0) }, want: int16(0), wantError: safemath.ErrTruncation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("got error %v, want %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	_, err := safemath.Parse[int16]("70000", 10)
+
+	var opErr *safemath.OpError
+	if !errors.As(err, &opErr) {
+		t.Fatalf("got error %T, want *safemath.OpError", err)
+	}
+	if opErr.Op != "Parse" || opErr.Type != "int16" || len(opErr.Operands) != 1 || opErr.Operands[0] != "70000" {
+		t.Errorf("got %+v", opErr)
+	}
+	if want := `Parse[int16]("70000"): integer type truncation (integer overflow)`; err.Error() != want {
+		t.Errorf("got message %q, want %q", err.Error(), want)
+	}
+}
+
+func TestParseBytesAllocs(t *testing.T) {
+	b := []byte("0x7fff_ffff")
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, err := safemath.ParseBytes[int32](b, 0); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("ParseBytes allocated %v times", allocs)
+	}
+}
+
+func TestMustParse(t *testing.T) {
+	if got := safemath.MustParse[uint16]("65535", 10); got != 65535 {
+		t.Errorf("MustParse(65535) = %d", got)
+	}
+	if got := safemath.MustParseBytes[int8]([]byte("-128"), 10); got != -128 {
+		t.Errorf("MustParseBytes(-128) = %d", got)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("MustParse did not panic on truncation")
+		}
+	}()
+	safemath.MustParse[uint16]("65536", 10)
+}
@@ -0,0 +1,119 @@
+package safemath
+
+import "math/bits"
+
+// Isqrt returns the integer square root of x, i.e. the largest r such that
+// r*r <= x. It is exact for every value, unlike a round trip through
+// math.Sqrt, and returns ErrDomain if x is negative.
+func Isqrt[T Integer](x T) (T, error) {
+	if x < 0 {
+		return 0, unaryOpError[T]("Isqrt", ErrDomain, nil, x)
+	}
+
+	return T(isqrt64(uint64(x))), nil
+}
+
+// Iroot returns the integer n-th root of x, truncated toward zero: the r of
+// largest magnitude, with the sign of x, such that |r|^n <= |x|.
+//
+// It returns ErrDomain if n is zero, or if x is negative and n is even.
+func Iroot[T Integer](x T, n uint) (T, error) {
+	if n == 0 || x < 0 && n&1 == 0 {
+		return 0, binaryOpError("Iroot", ErrDomain, nil, x, n)
+	}
+
+	r := iroot64(magnitude(x), n)
+	if x < 0 {
+		// |r| <= |x|, so the negation fits.
+		return -T(r), nil
+	}
+
+	return T(r), nil
+}
+
+// Cbrt returns the integer cube root of x, truncated toward zero. It is
+// [Iroot](x, 3), which is defined for every x and so cannot fail.
+func Cbrt[T Integer](x T) T {
+	r, _ := Iroot(x, 3)
+
+	return r
+}
+
+// MustIsqrt returns the integer square root of x on success. Panics on error.
+func MustIsqrt[T Integer](x T) T {
+	c, err := Isqrt(x)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustIroot returns the integer n-th root of x on success. Panics on error.
+func MustIroot[T Integer](x T, n uint) T {
+	c, err := Iroot(x, n)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// isqrt64 returns the integer square root of x using Newton's method.
+func isqrt64(x uint64) uint64 {
+	if x < 2 {
+		return x
+	}
+
+	// Start from a power of two no smaller than the root, so that the
+	// iterates decrease monotonically toward it.
+	r := uint64(1) << ((bits.Len64(x) + 1) / 2)
+	for {
+		next := (r + x/r) / 2
+		if next >= r {
+			return r
+		}
+		r = next
+	}
+}
+
+// iroot64 returns the integer n-th root of x, for n > 0, by determining the
+// root one bit at a time from the most significant.
+func iroot64(x uint64, n uint) uint64 {
+	switch {
+	case n == 1 || x < 2:
+		return x
+	case n == 2:
+		return isqrt64(x)
+	case n >= 64:
+		// 2^n > x for any x < 2^64.
+		return 1
+	}
+
+	var r uint64
+	for shift := (uint(bits.Len64(x)) - 1) / n; ; shift-- {
+		if c := r | 1<<shift; powAtMost(c, n, x) {
+			r = c
+		}
+
+		if shift == 0 {
+			return r
+		}
+	}
+}
+
+// powAtMost reports whether c^n <= x, for c > 0. Unlike [Pow], it stops at
+// the first partial product exceeding x instead of allocating an error.
+func powAtMost(c uint64, n uint, x uint64) bool {
+	p := uint64(1)
+	for ; n > 0; n-- {
+		// Partial products never decrease, so the first one above x decides.
+		hi, lo := bits.Mul64(p, c)
+		if hi != 0 || lo > x {
+			return false
+		}
+		p = lo
+	}
+
+	return true
+}
@@ -0,0 +1,192 @@
+package safemath_test
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"go.dw1.io/safemath"
+)
+
+func TestIsqrtExhaustive16(t *testing.T) {
+	r := 0
+	for x := 0; x <= math.MaxUint16; x++ {
+		if (r+1)*(r+1) <= x {
+			r++
+		}
+		if got, err := safemath.Isqrt(uint16(x)); err != nil || int(got) != r {
+			t.Fatalf("Isqrt[uint16](%d) = %d, %v; want %d", x, got, err, r)
+		}
+	}
+}
+
+func TestIrootExhaustive16(t *testing.T) {
+	for n := uint(1); n <= 17; n++ {
+		r := 0
+		for x := 0; x <= math.MaxInt16; x++ {
+			if p := new(big.Int).Exp(big.NewInt(int64(r+1)), big.NewInt(int64(n)), nil); p.Cmp(big.NewInt(int64(x))) <= 0 {
+				r++
+			}
+
+			got, err := safemath.Iroot(int16(x), n)
+			if err != nil || int(got) != r {
+				t.Fatalf("Iroot[int16](%d, %d) = %d, %v; want %d", x, n, got, err, r)
+			}
+
+			got, err = safemath.Iroot(int16(-x), n)
+			switch {
+			case x != 0 && n%2 == 0:
+				if !errors.Is(err, safemath.ErrDomain) {
+					t.Fatalf("Iroot[int16](%d, %d) error = %v; want ErrDomain", -x, n, err)
+				}
+			case err != nil || int(got) != -r:
+				t.Fatalf("Iroot[int16](%d, %d) = %d, %v; want %d", -x, n, got, err, -r)
+			}
+		}
+	}
+}
+
+func TestCbrt(t *testing.T) {
+	tests := []struct {
+		x, want int64
+	}{
+		{x: 0, want: 0},
+		{x: 26, want: 2},
+		{x: 27, want: 3},
+		{x: -28, want: -3},
+		{x: math.MaxInt64, want: 2097151},
+		{x: math.MinInt64, want: -2097152},
+	}
+
+	for _, tt := range tests {
+		if got := safemath.Cbrt(tt.x); got != tt.want {
+			t.Errorf("Cbrt(%d) = %d, want %d", tt.x, got, tt.want)
+		}
+	}
+
+	if got := safemath.Cbrt(uint64(math.MaxUint64)); got != 2642245 {
+		t.Errorf("Cbrt(MaxUint64) = %d, want 2642245", got)
+	}
+}
+
+func TestIrootAllocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		for n := uint(2); n < 64; n++ {
+			_, _ = safemath.Iroot(uint64(math.MaxUint64), n)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("Iroot allocated %v times, want 0", allocs)
+	}
+}
+
+func TestRoots(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() (any, error)
+		want      any
+		wantError error
+	}{
+		{
+			name: "Isqrt uint64 max",
+			fn:   func() (any, error) { return safemath.Isqrt(uint64(math.MaxUint64)) },
+			want: uint64(math.MaxUint32),
+		},
+		{
+			// float64(1<<64 - 1<<33) rounds up to 2^64, so a round trip
+			// through math.Sqrt is off by one.
+			name: "Isqrt below perfect square",
+			fn:   func() (any, error) { return safemath.Isqrt(uint64(math.MaxUint32*math.MaxUint32 - 1)) },
+			want: uint64(math.MaxUint32 - 1),
+		},
+		{
+			name: "Isqrt perfect square",
+			fn:   func() (any, error) { return safemath.Isqrt(uint64(math.MaxUint32 * math.MaxUint32)) },
+			want: uint64(math.MaxUint32),
+		},
+		{
+			name: "Isqrt int64 max",
+			fn:   func() (any, error) { return safemath.Isqrt(int64(math.MaxInt64)) },
+			want: int64(3037000499),
+		},
+		{
+			name:      "Isqrt negative",
+			fn:        func() (any, error) { return safemath.Isqrt(-1) },
+			want:      0,
+			wantError: safemath.ErrDomain,
+		},
+		{
+			name: "Iroot cube uint64 max",
+			fn:   func() (any, error) { return safemath.Iroot(uint64(math.MaxUint64), 3) },
+			want: uint64(2642245),
+		},
+		{
+			name: "Iroot cube int64 min",
+			fn:   func() (any, error) { return safemath.Iroot(int64(math.MinInt64), 3) },
+			want: int64(-2097152),
+		},
+		{
+			name: "Iroot 63rd root of int64 min",
+			fn:   func() (any, error) { return safemath.Iroot(int64(math.MinInt64), 63) },
+			want: int64(-2),
+		},
+		{
+			name: "Iroot 64th root",
+			fn:   func() (any, error) { return safemath.Iroot(uint64(math.MaxUint64), 64) },
+			want: uint64(1),
+		},
+		{
+			name: "Iroot huge n",
+			fn:   func() (any, error) { return safemath.Iroot(int8(-128), math.MaxUint) },
+			want: int8(-1),
+		},
+		{
+			name:      "Iroot zeroth root",
+			fn:        func() (any, error) { return safemath.Iroot(uint8(1), 0) },
+			want:      uint8(0),
+			wantError: safemath.ErrDomain,
+		},
+		{
+			name:      "Iroot even root of negative",
+			fn:        func() (any, error) { return safemath.Iroot(int32(-8), 4) },
+			want:      int32(0),
+			wantError: safemath.ErrDomain,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("got error %v, want %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMustRoots(t *testing.T) {
+	if got := safemath.MustIsqrt(99); got != 9 {
+		t.Errorf("MustIsqrt(99) = %d, want 9", got)
+	}
+	if got := safemath.MustIroot(-27, 3); got != -3 {
+		t.Errorf("MustIroot(-27, 3) = %d, want -3", got)
+	}
+
+	for name, fn := range map[string]func(){
+		"MustIsqrt": func() { safemath.MustIsqrt(-4) },
+		"MustIroot": func() { safemath.MustIroot(-4, 2) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%s did not panic", name)
+				}
+			}()
+			fn()
+		})
+	}
+}
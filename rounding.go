@@ -0,0 +1,83 @@
+package safemath
+
+import "strconv"
+
+// RoundingMode selects how a value that falls between two integers is
+// rounded to one of them. Functions taking a RoundingMode panic if given a
+// value other than the constants below.
+type RoundingMode uint8
+
+const (
+	// RoundTruncate rounds toward zero, like Go's integer division and
+	// float-to-integer conversions.
+	RoundTruncate RoundingMode = iota
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor
+	// RoundCeil rounds toward positive infinity.
+	RoundCeil
+	// RoundHalfEven rounds to the nearest integer, with ties to the even
+	// neighbor (banker's rounding).
+	RoundHalfEven
+	// RoundHalfAwayFromZero rounds to the nearest integer, with ties away
+	// from zero.
+	RoundHalfAwayFromZero
+)
+
+// String returns the name of the constant m, e.g. "RoundHalfEven".
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundTruncate:
+		return "RoundTruncate"
+	case RoundFloor:
+		return "RoundFloor"
+	case RoundCeil:
+		return "RoundCeil"
+	case RoundHalfEven:
+		return "RoundHalfEven"
+	case RoundHalfAwayFromZero:
+		return "RoundHalfAwayFromZero"
+	default:
+		return "RoundingMode(" + strconv.Itoa(int(m)) + ")"
+	}
+}
+
+// errInvalidRoundingMode is the panic value for unknown rounding modes.
+const errInvalidRoundingMode = "safemath: invalid rounding mode"
+
+// roundAway reports whether the magnitude of a truncated quotient must be
+// incremented to round it according to mode, given the magnitudes of the
+// remainder rem and divisor d (rem < d), whether the exact quotient is
+// negative, and whether the truncated quotient is odd.
+func roundAway(mode RoundingMode, rem, d uint64, neg, odd bool) bool {
+	// Compare rem with d-rem rather than 2*rem with d, which could overflow.
+	half := 0
+	switch {
+	case rem < d-rem:
+		half = -1
+	case rem > d-rem:
+		half = 1
+	}
+
+	return roundAwayCmp(mode, rem != 0, half, neg, odd)
+}
+
+// roundAwayCmp is like roundAway, for divisions wider than 64 bits. The
+// caller reports whether the division was inexact, and the result of
+// comparing the remainder with its distance to the divisor (-1, 0 or +1).
+func roundAwayCmp(mode RoundingMode, inexact bool, half int, neg, odd bool) bool {
+	// An exact quotient is never rounded; note that half < 0 in that case.
+	switch mode {
+	case RoundTruncate:
+		return false
+	case RoundFloor:
+		return inexact && neg
+	case RoundCeil:
+		return inexact && !neg
+	case RoundHalfEven:
+		return half > 0 || (half == 0 && odd)
+	case RoundHalfAwayFromZero:
+		return half >= 0
+	default:
+		panic(errInvalidRoundingMode)
+	}
+}
@@ -1,11 +1,18 @@
 package safemath
 
+import "unsafe"
+
 // Integer is a constraint that permits any integer type.
 type Integer interface {
 	~int | ~int8 | ~int16 | ~int32 | ~int64 |
 		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
 }
 
+// Signed is a constraint that permits any signed integer type.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
 // isSigned returns true if T is a signed integer type.
 func isSigned[T Integer]() bool {
 	var zero T
@@ -13,21 +20,32 @@ func isSigned[T Integer]() bool {
 	return ^zero < 0
 }
 
+// bitSize returns the size of T in bits.
+func bitSize[T Integer]() uint {
+	var zero T
+
+	return uint(unsafe.Sizeof(zero)) * 8
+}
+
+// minOf returns the smallest value representable by T.
+func minOf[T Integer]() T {
+	if isSigned[T]() {
+		return T(1) << (bitSize[T]() - 1)
+	}
+
+	return 0
+}
+
+// maxOf returns the largest value representable by T.
+func maxOf[T Integer]() T {
+	return ^minOf[T]()
+}
+
 // Add returns the sum of a and b, or an error if overflow occurs.
 func Add[T Integer](a, b T) (T, error) {
-	c := a + b
-	if isSigned[T]() {
-		// Signed overflow occurs if operands have the same sign and the result
-		// has a different sign.
-		if (a > 0 && b > 0 && c < 0) || (a < 0 && b < 0 && c > 0) {
-			return 0, ErrOverflow
-		}
-	} else {
-		// Unsigned overflow occurs if the result is less than one of the
-		// operands. (c < a) implies overflow.
-		if c < a {
-			return 0, ErrOverflow
-		}
+	c, overflow := OverflowingAdd(a, b)
+	if overflow {
+		return 0, binaryOpError("Add", ErrOverflow, direction(b < 0), a, b)
 	}
 
 	return c, nil
@@ -35,20 +53,9 @@ func Add[T Integer](a, b T) (T, error) {
 
 // Sub returns the difference of a and b, or an error if overflow occurs.
 func Sub[T Integer](a, b T) (T, error) {
-	c := a - b
-	if isSigned[T]() {
-		// Signed overflow occurs if operands have different signs and the
-		// result has a different sign than a.
-		// e.g., pos - neg = neg (Overflow)
-		//       neg - pos = pos (Underflow)
-		if (a > 0 && b < 0 && c < 0) || (a < 0 && b > 0 && c > 0) {
-			return 0, ErrOverflow
-		}
-	} else {
-		// Unsigned overflow (underflow) occurs if a < b.
-		if a < b {
-			return 0, ErrOverflow
-		}
+	c, overflow := OverflowingSub(a, b)
+	if overflow {
+		return 0, binaryOpError("Sub", ErrOverflow, direction(b > 0), a, b)
 	}
 
 	return c, nil
@@ -56,71 +63,183 @@ func Sub[T Integer](a, b T) (T, error) {
 
 // Mul returns the product of a and b, or an error if overflow occurs.
 func Mul[T Integer](a, b T) (T, error) {
-	if a == 0 || b == 0 {
-		return 0, nil
+	c, overflow := OverflowingMul(a, b)
+	if overflow {
+		return 0, binaryOpError("Mul", ErrOverflow, direction((a < 0) != (b < 0)), a, b)
 	}
 
-	// Specific edge case for signed integers: MinInt * -1
-	// The problem is that c/a != b check relies on division. If a is -1 and c
-	// is MinInt, c/a panics on some archs (MinInt / -1). So we must check for
-	// -1 * MinInt (or vice versa) before doing the division check.
-	if isSigned[T]() {
-		minOne := ^T(0)
-		if (a == minOne && b == -b) || (b == minOne && a == -a) {
-			return 0, ErrOverflow
-		}
-	}
+	return c, nil
+}
 
-	c := a * b
+// Div returns the quotient of a and b.
+func Div[T Integer](a, b T) (T, error) {
+	if b == 0 {
+		return 0, binaryOpError("Div", ErrDivisionByZero, nil, a, b)
+	}
 
-	// General overflow check (covers most cases).
-	if c/a != b {
-		return 0, ErrOverflow
+	c, overflow := OverflowingDiv(a, b)
+	if overflow {
+		return 0, binaryOpError("Div", ErrOverflow, ErrPositiveOverflow, a, b)
 	}
 
 	return c, nil
 }
 
-// Div returns the quotient of a and b.
-func Div[T Integer](a, b T) (T, error) {
+// Rem returns the remainder of a divided by b, truncated toward zero like
+// Go's % operator.
+//
+// Because the corresponding quotient overflows, MinInt % -1 is rejected with
+// ErrOverflow, consistently with [Div].
+func Rem[T Integer](a, b T) (T, error) {
 	if b == 0 {
-		return 0, ErrDivisionByZero
+		return 0, binaryOpError("Rem", ErrDivisionByZero, nil, a, b)
 	}
 
 	if isSigned[T]() {
 		minOne := ^T(0)
-		// Signed overflow: MinInt / -1
+		// Signed overflow: MinInt % -1
 		if b == minOne && a != 0 && a == -a {
-			return 0, ErrOverflow
+			return 0, binaryOpError("Rem", ErrOverflow, ErrPositiveOverflow, a, b)
 		}
 	}
 
-	return a / b, nil
+	return a % b, nil
 }
 
-// Convert safely converts a value from one Integer type to another.
-func Convert[To, From Integer](v From) (To, error) {
-	to := To(v)
+// Neg returns the negation of a, or an error if overflow occurs.
+//
+// For signed types, negating MinInt overflows. For unsigned types, any
+// non-zero value overflows.
+func Neg[T Integer](a T) (T, error) {
+	if a == 0 {
+		return 0, nil
+	}
+
+	if isSigned[T]() {
+		// Signed overflow: -MinInt
+		if a == -a {
+			return 0, unaryOpError[T]("Neg", ErrOverflow, direction(a > 0), a)
+		}
+	} else {
+		return 0, unaryOpError[T]("Neg", ErrOverflow, direction(a > 0), a)
+	}
+
+	return -a, nil
+}
+
+// Abs returns the absolute value of a, or an error if overflow occurs.
+//
+// For signed types, the absolute value of MinInt is not representable.
+// Unsigned values are returned unchanged.
+func Abs[T Integer](a T) (T, error) {
+	if a >= 0 {
+		return a, nil
+	}
+
+	// Signed overflow: |MinInt|
+	if a == -a {
+		return 0, unaryOpError[T]("Abs", ErrOverflow, ErrPositiveOverflow, a)
+	}
+
+	return -a, nil
+}
+
+// Shl returns v shifted left by n bits, or an error if any set bit (or, for
+// signed types, the sign) is shifted out.
+//
+// Shifting a non-zero value by n >= the bit size of T always overflows.
+func Shl[T Integer](v T, n uint) (T, error) {
+	c := v << n
+
+	// The shift is lossless only if shifting back restores the original
+	// value. The arithmetic right shift of signed types also catches a
+	// flipped sign bit.
+	if c>>n != v {
+		return 0, binaryOpError("Shl", ErrOverflow, direction(v < 0), v, n)
+	}
+
+	return c, nil
+}
+
+// Shr returns v shifted right by n bits, or ErrTruncation if any set bit is
+// shifted out (i.e., v is not evenly divisible by 2^n).
+//
+// Use [ShrTruncated] when discarding low bits is intended.
+func Shr[T Integer](v T, n uint) (T, error) {
+	c, truncated := ShrTruncated(v, n)
+	if truncated {
+		return 0, binaryOpError("Shr", ErrTruncation, nil, v, n)
+	}
+
+	return c, nil
+}
+
+// ShrTruncated returns v shifted right by n bits, rounding toward negative
+// infinity like Go's >> operator, and reports whether any set bit was shifted
+// out.
+//
+// Shifting by n >= the bit size of T returns 0 for non-negative values and -1
+// for negative ones, and is truncated unless v is 0.
+func ShrTruncated[T Integer](v T, n uint) (c T, truncated bool) {
+	c = v >> n
+
+	return c, c<<n != v
+}
 
-	if isSigned[From]() && !isSigned[To]() {
-		// Signed -> Unsigned
-		if v < 0 {
-			return 0, ErrTruncation
+// Pow returns base raised to the power of exp, or an error if overflow occurs.
+//
+// It uses exponentiation by squaring, checking every intermediate product
+// like [Mul] and returning as soon as one overflows. By convention, 0^0 is 1.
+func Pow[T Integer](base T, exp uint) (T, error) {
+	// Trivial bases never overflow, regardless of the exponent.
+	switch {
+	case exp == 0:
+		return 1, nil
+	case base == 0 || base == 1:
+		return base, nil
+	case isSigned[T]() && base == ^T(0):
+		// (-1)^n alternates between 1 and -1.
+		if exp&1 == 0 {
+			return 1, nil
 		}
+
+		return base, nil
 	}
 
-	if !isSigned[From]() && isSigned[To]() {
-		// Unsigned -> Signed
-		if to < 0 {
-			return 0, ErrTruncation
+	var overflow bool
+
+	b, e := base, exp
+	res := T(1)
+	for {
+		if e&1 == 1 {
+			if res, overflow = OverflowingMul(res, b); overflow {
+				return 0, binaryOpError("Pow", ErrOverflow, direction(base < 0 && exp&1 == 1), base, exp)
+			}
+		}
+
+		e >>= 1
+		if e == 0 {
+			break
+		}
+
+		// Only square when another iteration needs it, otherwise the final
+		// (unused) square could report a spurious overflow.
+		if b, overflow = OverflowingMul(b, b); overflow {
+			return 0, binaryOpError("Pow", ErrOverflow, direction(base < 0 && exp&1 == 1), base, exp)
 		}
 	}
 
-	if From(to) != v {
-		return 0, ErrTruncation
+	return res, nil
+}
+
+// Convert safely converts a value from one Integer type to another.
+func Convert[To, From Integer](v From) (To, error) {
+	c, ok := convert[To](v)
+	if !ok {
+		return 0, unaryOpError[To]("Convert", ErrTruncation, direction(v < 0), v)
 	}
 
-	return to, nil
+	return c, nil
 }
 
 // ConvertAny attempts to convert v (any integer type) into To.
@@ -151,7 +270,7 @@ func ConvertAny[To Integer](v any) (To, error) {
 	case uintptr:
 		return Convert[To](x)
 	default:
-		return 0, ErrInvalidType
+		return 0, unaryOpError[To]("ConvertAny", ErrInvalidType, nil, v)
 	}
 }
 
@@ -195,6 +314,66 @@ func MustDiv[T Integer](a, b T) T {
 	return c
 }
 
+// MustRem returns the remainder of a divided by b on success. Panics on error.
+func MustRem[T Integer](a, b T) T {
+	c, err := Rem(a, b)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustNeg returns the negation of a on success. Panics on error.
+func MustNeg[T Integer](a T) T {
+	c, err := Neg(a)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustAbs returns the absolute value of a on success. Panics on error.
+func MustAbs[T Integer](a T) T {
+	c, err := Abs(a)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustShl returns v shifted left by n bits on success. Panics on error.
+func MustShl[T Integer](v T, n uint) T {
+	c, err := Shl(v, n)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustShr returns v shifted right by n bits on success. Panics on error.
+func MustShr[T Integer](v T, n uint) T {
+	c, err := Shr(v, n)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustPow returns base raised to the power of exp on success. Panics on error.
+func MustPow[T Integer](base T, exp uint) T {
+	c, err := Pow(base, exp)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
 // MustConvert safely converts a value from one Integer type to another on success. Panics on error.
 func MustConvert[To, From Integer](v From) To {
 	c, err := Convert[To](v)
@@ -214,3 +393,21 @@ func MustConvertAny[To Integer](v any) To {
 
 	return c
 }
+
+// convert converts v to To, reporting whether the value was preserved
+// instead of allocating an error.
+func convert[To, From Integer](v From) (To, bool) {
+	to := To(v)
+
+	// Signed -> Unsigned
+	if isSigned[From]() && !isSigned[To]() && v < 0 {
+		return to, false
+	}
+
+	// Unsigned -> Signed
+	if !isSigned[From]() && isSigned[To]() && to < 0 {
+		return to, false
+	}
+
+	return to, From(to) == v
+}
@@ -130,6 +130,128 @@ func BenchmarkMustDiv(b *testing.B) {
 	_ = res
 }
 
+func BenchmarkRemInt64(b *testing.B) {
+	var res int64
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.Rem(int64(i), int64(7))
+	}
+	_ = res
+}
+
+func BenchmarkNegInt64(b *testing.B) {
+	var res int64
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.Neg(int64(i))
+	}
+	_ = res
+}
+
+func BenchmarkAbsInt64(b *testing.B) {
+	var res int64
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.Abs(-int64(i))
+	}
+	_ = res
+}
+
+func BenchmarkShlInt64(b *testing.B) {
+	var res int64
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.Shl(int64(i), 3)
+	}
+	_ = res
+}
+
+func BenchmarkShrUint64(b *testing.B) {
+	var res uint64
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.Shr(uint64(i)<<3, 3)
+	}
+	_ = res
+}
+
+func BenchmarkPowInt64(b *testing.B) {
+	var res int64
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.Pow(int64(3), uint(i%40))
+	}
+	_ = res
+}
+
+func BenchmarkSaturatingAddInt64(b *testing.B) {
+	var res int64
+	for i := 0; i < b.N; i++ {
+		res = safemath.SaturatingAdd(int64(i), int64(i))
+	}
+	_ = res
+}
+
+func BenchmarkSaturatingMulInt64(b *testing.B) {
+	var res int64
+	for i := 0; i < b.N; i++ {
+		res = safemath.SaturatingMul(int64(i), int64(i))
+	}
+	_ = res
+}
+
+func BenchmarkOverflowingAddInt64(b *testing.B) {
+	var res int64
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.OverflowingAdd(int64(i), int64(i))
+	}
+	_ = res
+}
+
+func BenchmarkOverflowingMulInt64(b *testing.B) {
+	var res int64
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.OverflowingMul(int64(i), int64(i))
+	}
+	_ = res
+}
+
+func BenchmarkMulFullInt64(b *testing.B) {
+	var hi, lo int64
+	for i := 0; i < b.N; i++ {
+		hi, lo = safemath.MulFull(int64(i), -int64(i))
+	}
+	_, _ = hi, lo
+}
+
+func BenchmarkDivFullInt64(b *testing.B) {
+	var quo, rem int64
+	for i := 0; i < b.N; i++ {
+		quo, rem, _ = safemath.DivFull(0, int64(i), 7)
+	}
+	_, _ = quo, rem
+}
+
+func BenchmarkInt128Mul(b *testing.B) {
+	var res safemath.Int128
+	y := safemath.Int128From(-3)
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.Int128From(i).Mul(y)
+	}
+	_ = res
+}
+
+func BenchmarkInt128Div(b *testing.B) {
+	var res safemath.Int128
+	x := safemath.Int128{Hi: 1 << 40, Lo: 12345}
+	for i := 0; i < b.N; i++ {
+		res, _ = x.Div(safemath.Int128From(i | 1))
+	}
+	_ = res
+}
+
+func BenchmarkChecked(b *testing.B) {
+	var res int64
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.NewChecked(int64(i)).Mul(3).Add(7).Div(2).Result()
+	}
+	_ = res
+}
+
 // Safemath Conversion Benchmarks
 
 func BenchmarkConvertSignedToInt8(b *testing.B) {
@@ -156,6 +278,31 @@ func BenchmarkConvertUnsignedToSigned(b *testing.B) {
 	_ = res
 }
 
+func BenchmarkFromFloat(b *testing.B) {
+	var res int32
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.FromFloat[int32](float64(i)+0.5, safemath.RoundHalfEven)
+	}
+	_ = res
+}
+
+func BenchmarkParse(b *testing.B) {
+	var res int32
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.Parse[int32]("-2147483648", 10)
+	}
+	_ = res
+}
+
+func BenchmarkParseBytes(b *testing.B) {
+	var res int32
+	s := []byte("0x7fff_ffff")
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.ParseBytes[int32](s, 0)
+	}
+	_ = res
+}
+
 func BenchmarkMustConvert(b *testing.B) {
 	var res int8
 	for i := 0; i < b.N; i++ {
@@ -179,3 +326,126 @@ func BenchmarkMustConvertAny(b *testing.B) {
 	}
 	_ = res
 }
+
+func BenchmarkSum(b *testing.B) {
+	xs := make([]uint32, 1024)
+	for i := range xs {
+		xs[i] = uint32(i)
+	}
+
+	var res uint32
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.Sum(xs)
+	}
+	_ = res
+}
+
+func BenchmarkProduct(b *testing.B) {
+	xs := []int64{3, -5, 7, 11, -13, 17, 19, 23}
+
+	var res int64
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.Product(xs)
+	}
+	_ = res
+}
+
+func BenchmarkSumExact(b *testing.B) {
+	xs := make([]int64, 1024)
+	for i := range xs {
+		xs[i] = int64(i) - 512
+	}
+
+	var res int64
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.SumExact(xs)
+	}
+	_ = res
+}
+
+func BenchmarkMean(b *testing.B) {
+	xs := make([]int64, 1024)
+	for i := range xs {
+		xs[i] = int64(i) - 512
+	}
+
+	var res int64
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.Mean(xs, safemath.RoundHalfEven)
+	}
+	_ = res
+}
+
+func BenchmarkMulDiv(b *testing.B) {
+	var res int64
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.MulDiv(int64(i)<<32, 7, 9, safemath.RoundHalfEven)
+	}
+	_ = res
+}
+
+func BenchmarkDivRound(b *testing.B) {
+	var res int
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.DivRound(i-1000, 7, safemath.RoundHalfEven)
+	}
+	_ = res
+}
+
+func BenchmarkGCD(b *testing.B) {
+	var res uint64
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.GCD(uint64(i)*2654435761, 1<<40-1)
+	}
+	_ = res
+}
+
+func BenchmarkModInverse(b *testing.B) {
+	var res int64
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.ModInverse(int64(i)|1, 1<<61-1)
+	}
+	_ = res
+}
+
+func BenchmarkIsqrt(b *testing.B) {
+	var res uint64
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.Isqrt(uint64(i) * 2654435761)
+	}
+	_ = res
+}
+
+func BenchmarkILog10(b *testing.B) {
+	var res uint
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.ILog10(uint64(i) + 1)
+	}
+	_ = res
+}
+
+func BenchmarkConvertClamp(b *testing.B) {
+	var res int8
+	for i := 0; i < b.N; i++ {
+		res = safemath.ConvertClamp[int8](int64(i%512 - 256))
+	}
+	_ = res
+}
+
+func BenchmarkDecimalMul(b *testing.B) {
+	x, _ := safemath.NewDecimal(1999, 2)
+	y, _ := safemath.NewDecimal(825, 4)
+	var res safemath.Decimal
+	for i := 0; i < b.N; i++ {
+		res, _ = x.Mul(y, 2, safemath.RoundHalfEven)
+	}
+	_ = res
+}
+
+func BenchmarkIroot(b *testing.B) {
+	var res uint64
+	for i := 0; i < b.N; i++ {
+		res, _ = safemath.Iroot(uint64(i)*2654435761, 5)
+	}
+	_ = res
+}
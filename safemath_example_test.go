@@ -1,8 +1,10 @@
 package safemath_test
 
 import (
+	"errors"
 	"fmt"
 	"math"
+	"math/big"
 
 	"go.dw1.io/safemath"
 )
@@ -22,7 +24,7 @@ func ExampleAdd() {
 	}
 	// Output:
 	// 30
-	// integer overflow/underflow
+	// Add[int8](127, 1): integer overflow
 }
 
 func ExampleMul() {
@@ -40,7 +42,7 @@ func ExampleMul() {
 	}
 	// Output:
 	// 100
-	// integer overflow/underflow
+	// Mul[int8](127, 2): integer overflow
 }
 
 func ExampleSub() {
@@ -58,7 +60,7 @@ func ExampleSub() {
 	}
 	// Output:
 	// 70
-	// integer overflow/underflow
+	// Sub[int8](-128, 1): integer underflow
 }
 
 func ExampleDiv() {
@@ -76,7 +78,406 @@ func ExampleDiv() {
 	}
 	// Output:
 	// 25
-	// division by zero
+	// Div[int](100, 0): division by zero
+}
+
+func ExampleDivCeil() {
+	// Number of pages needed for 101 items, 20 per page
+	fmt.Println(safemath.DivCeil(101, 20))
+
+	// (a + b - 1) / b would overflow here
+	fmt.Println(safemath.DivCeil(uint8(255), 2))
+	// Output:
+	// 6 <nil>
+	// 128 <nil>
+}
+
+func ExampleDivRound() {
+	fmt.Println(safemath.DivRound(-5, 2, safemath.RoundHalfEven))
+	fmt.Println(safemath.DivRound(-5, 2, safemath.RoundHalfAwayFromZero))
+	fmt.Println(safemath.DivFloor(-5, 2))
+	// Output:
+	// -2 <nil>
+	// -3 <nil>
+	// -3 <nil>
+}
+
+func ExampleRemEuclid() {
+	// Index into a ring buffer of 8 slots, stepping backwards
+	fmt.Println(safemath.Rem(-3, 8))
+	fmt.Println(safemath.RemEuclid(-3, 8))
+
+	// Mod takes the sign of the divisor, like Python
+	fmt.Println(safemath.Mod(3, -8))
+	// Output:
+	// -3 <nil>
+	// 5 <nil>
+	// -5 <nil>
+}
+
+func ExampleRem() {
+	// Normal remainder (sign follows the dividend)
+	rem, err := safemath.Rem(-7, 3)
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(rem)
+
+	// MinInt % -1
+	_, err = safemath.Rem[int8](math.MinInt8, -1)
+	if err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// -1
+	// Rem[int8](-128, -1): integer overflow
+}
+
+func ExampleNeg() {
+	// Normal negation
+	neg, err := safemath.Neg(42)
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(neg)
+
+	// Negating MinInt overflows
+	_, err = safemath.Neg[int8](math.MinInt8)
+	if err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// -42
+	// Neg[int8](-128): integer overflow
+}
+
+func ExampleAbs() {
+	// Normal absolute value
+	abs, err := safemath.Abs(-42)
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(abs)
+
+	// |MinInt| is not representable
+	_, err = safemath.Abs[int64](math.MinInt64)
+	if err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// 42
+	// Abs[int64](-9223372036854775808): integer overflow
+}
+
+func ExampleShl() {
+	// Lossless shift
+	v, err := safemath.Shl[uint8](0x0F, 4)
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(v)
+
+	// High bit shifted out
+	_, err = safemath.Shl[uint8](0x80, 1)
+	if err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// 240
+	// Shl[uint8](128, 1): integer overflow
+}
+
+func ExampleShr() {
+	// Exact shift
+	v, err := safemath.Shr(96, 5)
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(v)
+
+	// Low bit shifted out
+	_, err = safemath.Shr(97, 5)
+	if err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// 3
+	// Shr[int](97, 5): integer type truncation
+}
+
+func ExampleShrTruncated() {
+	// Discarding low bits on purpose, while still knowing about it
+	fmt.Println(safemath.ShrTruncated(int8(-1), 1))
+	fmt.Println(safemath.ShrTruncated(uint8(0xF0), 4))
+	fmt.Println(safemath.ShrTruncated(uint8(0xFF), 8))
+	// Output:
+	// -1 true
+	// 15 false
+	// 0 true
+}
+
+func ExamplePow() {
+	// Normal exponentiation
+	pow, err := safemath.Pow(-2, 5)
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(pow)
+
+	// Overflow exponentiation
+	_, err = safemath.Pow[int32](10, 10)
+	if err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// -32
+	// Pow[int32](10, 10): integer overflow
+}
+
+func ExampleSaturatingAdd() {
+	// Normal addition
+	fmt.Println(safemath.SaturatingAdd[int8](100, 20))
+
+	// Overflow clamps to the bounds of the type
+	fmt.Println(safemath.SaturatingAdd[int8](100, 100))
+	fmt.Println(safemath.SaturatingAdd[int8](-100, -100))
+	// Output:
+	// 120
+	// 127
+	// -128
+}
+
+func ExampleSaturatingConvert() {
+	// Values outside the target range are clamped
+	fmt.Println(safemath.SaturatingConvert[uint8](300))
+	fmt.Println(safemath.SaturatingConvert[uint8](-5))
+	fmt.Println(safemath.SaturatingConvert[int8](uint64(42)))
+	// Output:
+	// 255
+	// 0
+	// 42
+}
+
+func ExampleConvertClamped() {
+	// A protocol field only holds 16 bits
+	fmt.Println(safemath.ConvertClamped[uint16](70000))
+	fmt.Println(safemath.ConvertClamped[uint16](1500))
+	// Output:
+	// 65535 true
+	// 1500 false
+}
+
+func ExampleOverflowingAdd() {
+	// Normal addition
+	fmt.Println(safemath.OverflowingAdd[uint8](200, 50))
+
+	// Overflow wraps around and is reported
+	fmt.Println(safemath.OverflowingAdd[uint8](200, 100))
+	// Output:
+	// 250 false
+	// 44 true
+}
+
+func ExampleOverflowingMul() {
+	// Deliberate wrapping, e.g. in an FNV-1a hash
+	hash := uint32(2166136261)
+	for _, c := range []byte("safemath") {
+		hash ^= uint32(c)
+		hash, _ = safemath.OverflowingMul(hash, 16777619)
+	}
+	fmt.Println(hash)
+
+	// Overflow is still reported
+	fmt.Println(safemath.OverflowingMul[int8](64, 2))
+	// Output:
+	// 269952438
+	// -128 true
+}
+
+func ExampleMulFull() {
+	// The full 128-bit product of two uint64 values
+	hi, lo := safemath.MulFull[uint64](math.MaxUint64, 3)
+	fmt.Println(hi, lo)
+
+	// Signed products carry the sign in the high half
+	hi8, lo8 := safemath.MulFull[int8](-100, 100)
+	fmt.Println(hi8, uint8(lo8))
+	// Output:
+	// 2 18446744073709551613
+	// -40 240
+}
+
+func ExampleDivFull() {
+	// Divide a 128-bit product back down
+	hi, lo := safemath.MulFull[uint64](math.MaxUint64, 3)
+	quo, rem, err := safemath.DivFull(hi, lo, 4)
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(quo, rem)
+
+	// Quotient does not fit in uint64
+	_, _, err = safemath.DivFull(hi, lo, 2)
+	if err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// 13835058055282163711 1
+	// DivFull[uint64](2, 18446744073709551613, 2): integer overflow
+}
+
+func ExampleMulDiv() {
+	// Scale an amount by 7/9 without overflowing the intermediate product
+	amount := int64(math.MaxInt64 / 2)
+	fmt.Println(safemath.MulDiv(amount, 7, 9, safemath.RoundHalfEven))
+
+	// Only the final result must fit
+	_, err := safemath.MulDiv(amount, 9, 4, safemath.RoundTruncate)
+	fmt.Println(err)
+	// Output:
+	// 3586866903221301702 <nil>
+	// MulDiv[int64](4611686018427387903, 9, 4): integer overflow
+}
+
+func ExampleLCM() {
+	// Two schedules line up every LCM ticks
+	fmt.Println(safemath.LCM(int64(6), 10))
+
+	// Unless that is beyond int64
+	_, err := safemath.LCM(int64(1<<40+1), 1<<40-1)
+	fmt.Println(err)
+	// Output:
+	// 30 <nil>
+	// LCM[int64](1099511627777, 1099511627775): integer overflow
+}
+
+func ExampleExtendedGCD() {
+	// 240*x + 46*y = 2
+	fmt.Println(safemath.ExtendedGCD(int64(240), 46))
+	// Output:
+	// 2 -9 47 <nil>
+}
+
+func ExampleModInverse() {
+	fmt.Println(safemath.ModInverse(3, 11))
+
+	_, err := safemath.ModInverse(6, 9)
+	fmt.Println(err)
+	// Output:
+	// 4 <nil>
+	// ModInverse[int](6, 9): no modular inverse
+}
+
+func ExampleIsqrt() {
+	// int(math.Sqrt(float64(x))) would return 4294967295 here
+	fmt.Println(safemath.Isqrt(uint64(18446744065119617024)))
+
+	_, err := safemath.Isqrt(-1)
+	fmt.Println(err)
+	// Output:
+	// 4294967294 <nil>
+	// Isqrt[int](-1): argument outside of domain
+}
+
+func ExampleILog10() {
+	// Number of decimal digits, minus one
+	fmt.Println(safemath.ILog10(uint64(math.MaxUint64)))
+	fmt.Println(safemath.ILog(uint64(math.MaxUint64), 16))
+	// Output:
+	// 19 <nil>
+	// 15 <nil>
+}
+
+func ExampleBounds() {
+	percent := safemath.Bounds[int8]{Min: 0, Max: 100}
+	fmt.Println(percent.Add(60, 40))
+
+	_, err := percent.Add(60, 41)
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, safemath.ErrOutOfRange))
+	// Output:
+	// 100 <nil>
+	// Add[int8](60, 41): value out of range (above maximum)
+	// true
+}
+
+func ExampleInt128() {
+	// Values beyond int64 without math/big
+	a := safemath.Int128From(int64(math.MaxInt64))
+	b, err := a.Mul(safemath.Int128From(1000))
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(b)
+
+	// Narrowing back checks the range
+	_, err = safemath.ConvertInt128[int64](b)
+	if err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// 9223372036854775807000
+	// ConvertInt128[int64](9223372036854775807000): integer type truncation (integer overflow)
+}
+
+func ExampleDecimal() {
+	price, _ := safemath.ParseDecimal("19.99")
+	rate, _ := safemath.ParseDecimal("0.0825")
+
+	qty, _ := safemath.NewDecimal(3, 0)
+
+	subtotal, _ := price.Mul(qty, 2, safemath.RoundHalfEven)
+	fmt.Println(subtotal)
+
+	// Round the tax to cents explicitly
+	tax, _ := subtotal.Mul(rate, 2, safemath.RoundHalfEven)
+	fmt.Println(tax)
+
+	total, _ := subtotal.Add(tax)
+	fmt.Println(total)
+
+	_, err := safemath.ParseDecimal("92233720368547758.08")
+	fmt.Println(err)
+	// Output:
+	// 59.97
+	// 4.95
+	// 64.92
+	// ParseDecimal[Decimal]("92233720368547758.08"): integer overflow
+}
+
+func ExampleParseUint128() {
+	x, err := safemath.ParseUint128("340282366920938463463374607431768211455")
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(x.Hi == math.MaxUint64, x.Lo == math.MaxUint64)
+
+	// Adding one overflows
+	_, err = x.Add(safemath.Uint128{Lo: 1})
+	if err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// true true
+	// integer overflow/underflow
+}
+
+func ExampleChecked() {
+	// Write the formula once, check it once
+	total, err := safemath.NewChecked[int64](1_000_000).Mul(3_000).Add(25).Div(4).Result()
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(total)
+
+	// Narrower types fail at the first overflowing step
+	_, err = safemath.NewChecked[int32](1_000_000).Mul(3_000).Add(25).Div(4).Result()
+	if err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// 750000006
+	// step 1 (Mul): Mul[int32](1000000, 3000): integer overflow
 }
 
 func ExampleConvert() {
@@ -100,8 +501,8 @@ func ExampleConvert() {
 	}
 	// Output:
 	// 100
-	// integer type truncation
-	// integer type truncation
+	// Convert[int8](200): integer type truncation (integer overflow)
+	// Convert[uint](-1): integer type truncation (integer underflow)
 }
 
 func ExampleConvertAny() {
@@ -120,7 +521,149 @@ func ExampleConvertAny() {
 	}
 	// Output:
 	// 42
-	// invalid integer type
+	// ConvertAny[int]("nope"): invalid integer type
+}
+
+func ExampleOpError() {
+	_, err := safemath.Mul[int32](100_000, 100_000)
+
+	// The sentinel error is still matched
+	fmt.Println(errors.Is(err, safemath.ErrOverflow))
+
+	// The failing inputs can be inspected
+	var opErr *safemath.OpError
+	if errors.As(err, &opErr) {
+		fmt.Println(opErr.Op, opErr.Type, opErr.Operands)
+	}
+	// Output:
+	// true
+	// Mul int32 [100000 100000]
+}
+
+func ExampleErrNegativeOverflow() {
+	balance, debit := int64(math.MinInt64+10), int64(20)
+
+	_, err := safemath.Sub(balance, debit)
+	switch {
+	case errors.Is(err, safemath.ErrNegativeOverflow):
+		fmt.Println("below minimum")
+	case errors.Is(err, safemath.ErrPositiveOverflow):
+		fmt.Println("above maximum")
+	}
+
+	// Existing checks keep working
+	fmt.Println(errors.Is(err, safemath.ErrOverflow))
+	// Output:
+	// below minimum
+	// true
+}
+
+func ExampleFromFloat() {
+	// Rounding modes
+	fmt.Println(safemath.FromFloat[int32](2.5, safemath.RoundHalfEven))
+	fmt.Println(safemath.FromFloat[int32](2.5, safemath.RoundHalfAwayFromZero))
+	fmt.Println(safemath.FromFloat[int32](-2.5, safemath.RoundFloor))
+
+	// Out-of-range and non-finite values are rejected
+	_, err := safemath.FromFloat[int32](3e9, safemath.RoundTruncate)
+	fmt.Println(err)
+	_, err = safemath.FromFloat[int32](math.NaN(), safemath.RoundTruncate)
+	fmt.Println(err)
+	// Output:
+	// 2 <nil>
+	// 3 <nil>
+	// -3 <nil>
+	// FromFloat[int32](3e+09): integer type truncation (integer overflow)
+	// FromFloat[int32](NaN): floating-point value is not finite
+}
+
+func ExampleToFloat64() {
+	// IDs up to 2^53 survive the conversion
+	fmt.Println(safemath.ToFloat64(int64(9007199254740992)))
+
+	// Larger ones may not
+	_, err := safemath.ToFloat64(int64(9007199254740993))
+	fmt.Println(err)
+	// Output:
+	// 9.007199254740992e+15 <nil>
+	// ToFloat64[float64](9007199254740993): integer type truncation
+}
+
+func ExampleFromBigInt() {
+	// Compute in math/big, then narrow back into a column type
+	x := new(big.Int).Exp(big.NewInt(2), big.NewInt(31), nil)
+	fmt.Println(safemath.FromBigInt[int64](x))
+
+	_, err := safemath.FromBigInt[int32](x)
+	fmt.Println(err)
+
+	// Rationals must be exact integers
+	_, err = safemath.FromBigRat[int32](big.NewRat(7, 2))
+	fmt.Println(err)
+	// Output:
+	// 2147483648 <nil>
+	// FromBigInt[int32](2147483648): integer type truncation (integer overflow)
+	// FromBigRat[int32](7/2): integer type truncation
+}
+
+func ExampleSum() {
+	fmt.Println(safemath.Sum([]uint32{1 << 30, 1 << 30, 1 << 30}))
+
+	// The error identifies the element that overflowed
+	_, err := safemath.Sum([]uint32{1 << 31, 1 << 30, 1 << 30, 1})
+	fmt.Println(err)
+	// Output:
+	// 3221225472 <nil>
+	// index 2 (Sum): Add[uint32](3221225472, 1073741824): integer overflow
+}
+
+func ExampleSumExact() {
+	// Sum fails on the intermediate overflow, SumExact only checks the total
+	xs := []int64{math.MaxInt64, 1, -1}
+	fmt.Println(safemath.Sum(xs))
+	fmt.Println(safemath.SumExact(xs))
+	// Output:
+	// 0 index 1 (Sum): Add[int64](9223372036854775807, 1): integer overflow
+	// 9223372036854775807 <nil>
+}
+
+func ExampleMidpoint() {
+	// (a+b)/2 would overflow
+	fmt.Println(safemath.Midpoint(int8(120), int8(126)))
+
+	// Rounds toward negative infinity
+	fmt.Println(safemath.Midpoint(-3, 0))
+	// Output:
+	// 123
+	// -2
+}
+
+func ExampleMean() {
+	xs := []int64{math.MaxInt64, math.MaxInt64 - 1}
+	fmt.Println(safemath.Mean(xs, safemath.RoundFloor))
+	fmt.Println(safemath.Mean(xs, safemath.RoundCeil))
+	// Output:
+	// 9223372036854775806 <nil>
+	// 9223372036854775807 <nil>
+}
+
+func ExampleParse() {
+	// Bounds are determined by the target type
+	fmt.Println(safemath.Parse[int16]("-32768", 10))
+
+	// Base 0 accepts Go integer literal syntax
+	fmt.Println(safemath.Parse[uint32]("0xFFFF_FFFF", 0))
+
+	// Errors carry the offending text
+	_, err := safemath.Parse[int16]("40000", 10)
+	fmt.Println(err)
+	_, err = safemath.Parse[int16]("12abc", 10)
+	fmt.Println(err)
+	// Output:
+	// -32768 <nil>
+	// 4294967295 <nil>
+	// Parse[int16]("40000"): integer type truncation (integer overflow)
+	// Parse[int16]("12abc"): invalid syntax
 }
 
 func ExampleMustAdd() {
@@ -137,7 +680,7 @@ func ExampleMustAdd() {
 
 	// Output:
 	// 300
-	// Recovered from: integer overflow/underflow
+	// Recovered from: Add[int8](127, 1): integer overflow
 }
 
 func ExampleMustConvert() {
@@ -155,7 +698,7 @@ func ExampleMustConvert() {
 
 	// Output:
 	// 10000
-	// Recovered from: integer type truncation
+	// Recovered from: Convert[int8](200): integer type truncation (integer overflow)
 }
 
 func ExampleMustConvertAny() {
@@ -173,7 +716,7 @@ func ExampleMustConvertAny() {
 
 	// Output:
 	// 255
-	// Recovered from: invalid integer type
+	// Recovered from: ConvertAny[int]("bad"): invalid integer type
 }
 
 func ExampleMustSub() {
@@ -190,7 +733,7 @@ func ExampleMustSub() {
 
 	// Output:
 	// 60
-	// Recovered from: integer overflow/underflow
+	// Recovered from: Sub[uint8](0, 1): integer underflow
 }
 
 func ExampleMustMul() {
@@ -207,7 +750,7 @@ func ExampleMustMul() {
 
 	// Output:
 	// 100
-	// Recovered from: integer overflow/underflow
+	// Recovered from: Mul[int8](100, 2): integer overflow
 }
 
 func ExampleMustDiv() {
@@ -224,5 +767,5 @@ func ExampleMustDiv() {
 
 	// Output:
 	// 10
-	// Recovered from: division by zero
+	// Recovered from: Div[int](1, 0): division by zero
 }
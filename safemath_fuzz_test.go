@@ -1,6 +1,10 @@
 package safemath_test
 
 import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
 	"testing"
 
 	"go.dw1.io/safemath"
@@ -58,6 +62,68 @@ func FuzzDiv(f *testing.F) {
 	})
 }
 
+func FuzzRem(f *testing.F) {
+	f.Add(int64(100), int64(7))
+	f.Fuzz(func(t *testing.T, a, b int64) {
+		res, err := safemath.Rem(a, b)
+		checkConsistency(t, res, err, func() int64 { return safemath.MustRem(a, b) })
+	})
+}
+
+func FuzzNeg(f *testing.F) {
+	f.Add(int64(10))
+	f.Fuzz(func(t *testing.T, a int64) {
+		res, err := safemath.Neg(a)
+		checkConsistency(t, res, err, func() int64 { return safemath.MustNeg(a) })
+	})
+}
+
+func FuzzAbs(f *testing.F) {
+	f.Add(int64(-10))
+	f.Fuzz(func(t *testing.T, a int64) {
+		res, err := safemath.Abs(a)
+		checkConsistency(t, res, err, func() int64 { return safemath.MustAbs(a) })
+	})
+}
+
+func FuzzShl(f *testing.F) {
+	f.Add(int64(3), uint(4))
+	f.Fuzz(func(t *testing.T, a int64, n uint) {
+		res, err := safemath.Shl(a, n)
+		checkConsistency(t, res, err, func() int64 { return safemath.MustShl(a, n) })
+	})
+}
+
+func FuzzShr(f *testing.F) {
+	f.Add(int64(48), uint(4))
+	f.Fuzz(func(t *testing.T, a int64, n uint) {
+		res, err := safemath.Shr(a, n)
+		checkConsistency(t, res, err, func() int64 { return safemath.MustShr(a, n) })
+	})
+}
+
+func FuzzPow(f *testing.F) {
+	f.Add(int64(-3), uint(5))
+	f.Fuzz(func(t *testing.T, a int64, n uint) {
+		res, err := safemath.Pow(a, n)
+		checkConsistency(t, res, err, func() int64 { return safemath.MustPow(a, n) })
+
+		// Cross-check against repeated multiplication for small exponents.
+		if n <= 64 {
+			want, wantErr := int64(1), error(nil)
+			for i := uint(0); i < n && wantErr == nil; i++ {
+				want, wantErr = safemath.Mul(want, a)
+			}
+			if wantErr != nil {
+				want = 0
+			}
+			if res != want || (err != nil) != (wantErr != nil) {
+				t.Errorf("Pow(%d, %d) = %d, %v; want %d, %v", a, n, res, err, want, wantErr)
+			}
+		}
+	})
+}
+
 func FuzzArithmeticUint64(f *testing.F) {
 	f.Add(uint64(10), uint64(5))
 	f.Fuzz(func(t *testing.T, a, b uint64) {
@@ -73,7 +139,13 @@ func FuzzArithmeticUint64(f *testing.F) {
 		if b != 0 {
 			rDiv, eDiv := safemath.Div(a, b)
 			checkConsistency(t, rDiv, eDiv, func() uint64 { return safemath.MustDiv(a, b) })
+
+			rRem, eRem := safemath.Rem(a, b)
+			checkConsistency(t, rRem, eRem, func() uint64 { return safemath.MustRem(a, b) })
 		}
+
+		rNeg, eNeg := safemath.Neg(a)
+		checkConsistency(t, rNeg, eNeg, func() uint64 { return safemath.MustNeg(a) })
 	})
 }
 
@@ -134,3 +206,471 @@ func FuzzConvertAnyUint64ToInt64(f *testing.F) {
 		checkConsistency(t, res, err, func() int64 { return safemath.MustConvertAny[int64](a) })
 	})
 }
+
+// FuzzSaturatingAdd verifies that saturating results agree with the checked
+// result whenever it does not overflow, and lie on a bound otherwise.
+func FuzzSaturatingAdd(f *testing.F) {
+	f.Add(int64(1), int64(2))
+	f.Add(int64(math.MaxInt64), int64(1))
+	f.Fuzz(func(t *testing.T, a, b int64) {
+		got := safemath.SaturatingAdd(a, b)
+		want, err := safemath.Add(a, b)
+		if err != nil {
+			want = math.MaxInt64
+			if b < 0 {
+				want = math.MinInt64
+			}
+		}
+		if got != want {
+			t.Errorf("SaturatingAdd(%d, %d) = %d; want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzSaturatingMul(f *testing.F) {
+	f.Add(int64(10), int64(10))
+	f.Add(int64(math.MinInt64), int64(-1))
+	f.Fuzz(func(t *testing.T, a, b int64) {
+		got := safemath.SaturatingMul(a, b)
+		want, err := safemath.Mul(a, b)
+		if err != nil {
+			want = math.MaxInt64
+			if (a < 0) != (b < 0) {
+				want = math.MinInt64
+			}
+		}
+		if got != want {
+			t.Errorf("SaturatingMul(%d, %d) = %d; want %d", a, b, got, want)
+		}
+	})
+}
+
+// FuzzOverflowing verifies that overflowing variants always return the
+// natively wrapped result, and report overflow exactly when the checked
+// variants fail.
+func FuzzOverflowing(f *testing.F) {
+	f.Add(int64(1), int64(2))
+	f.Add(int64(math.MinInt64), int64(-1))
+	f.Fuzz(func(t *testing.T, a, b int64) {
+		if got, overflow := safemath.OverflowingAdd(a, b); got != a+b {
+			t.Errorf("OverflowingAdd(%d, %d) = %d; want %d", a, b, got, a+b)
+		} else if _, err := safemath.Add(a, b); overflow != (err != nil) {
+			t.Errorf("OverflowingAdd(%d, %d) overflow = %v; Add error = %v", a, b, overflow, err)
+		}
+
+		if got, overflow := safemath.OverflowingSub(a, b); got != a-b {
+			t.Errorf("OverflowingSub(%d, %d) = %d; want %d", a, b, got, a-b)
+		} else if _, err := safemath.Sub(a, b); overflow != (err != nil) {
+			t.Errorf("OverflowingSub(%d, %d) overflow = %v; Sub error = %v", a, b, overflow, err)
+		}
+
+		if got, overflow := safemath.OverflowingMul(a, b); got != a*b {
+			t.Errorf("OverflowingMul(%d, %d) = %d; want %d", a, b, got, a*b)
+		} else if _, err := safemath.Mul(a, b); overflow != (err != nil) {
+			t.Errorf("OverflowingMul(%d, %d) overflow = %v; Mul error = %v", a, b, overflow, err)
+		}
+
+		if b != 0 {
+			if got, overflow := safemath.OverflowingDiv(a, b); got != a/b {
+				t.Errorf("OverflowingDiv(%d, %d) = %d; want %d", a, b, got, a/b)
+			} else if _, err := safemath.Div(a, b); overflow != (err != nil) {
+				t.Errorf("OverflowingDiv(%d, %d) overflow = %v; Div error = %v", a, b, overflow, err)
+			}
+		}
+	})
+}
+
+// bigFull returns the 2N-bit value (hi, lo) as a big.Int.
+func bigFull(hi int64, lo uint64) *big.Int {
+	v := new(big.Int).Lsh(big.NewInt(hi), 64)
+	return v.Or(v, new(big.Int).SetUint64(lo))
+}
+
+func FuzzMulFull(f *testing.F) {
+	f.Add(int64(math.MinInt64), int64(-1))
+	f.Add(int64(math.MaxInt64), int64(3))
+	f.Fuzz(func(t *testing.T, a, b int64) {
+		hi, lo := safemath.MulFull(a, b)
+		want := new(big.Int).Mul(big.NewInt(a), big.NewInt(b))
+		if got := bigFull(hi, uint64(lo)); got.Cmp(want) != 0 {
+			t.Fatalf("MulFull(%d, %d) = %v; want %v", a, b, got, want)
+		}
+
+		if b == 0 {
+			return
+		}
+
+		quo, rem, err := safemath.DivFull(hi, lo, b)
+		if err != nil || quo != a || rem != 0 {
+			t.Fatalf("DivFull(MulFull(%d, %d), %d) = (%d, %d, %v)", a, b, b, quo, rem, err)
+		}
+	})
+}
+
+// FuzzInt128 cross-checks Int128 arithmetic against math/big.
+func FuzzInt128(f *testing.F) {
+	f.Add(int64(math.MinInt64), uint64(0), int64(-1), uint64(math.MaxUint64))
+	f.Add(int64(0), uint64(math.MaxUint64), int64(0), uint64(3))
+	f.Add(int64(1), uint64(5), int64(0), uint64(0))
+	f.Fuzz(func(t *testing.T, ahi int64, alo uint64, bhi int64, blo uint64) {
+		a, b := safemath.Int128{Hi: ahi, Lo: alo}, safemath.Int128{Hi: bhi, Lo: blo}
+		ba, bb := bigFull(ahi, alo), bigFull(bhi, blo)
+
+		minInt128 := new(big.Int).Lsh(big.NewInt(-1), 127)
+		maxInt128 := new(big.Int).Sub(new(big.Int).Neg(minInt128), big.NewInt(1))
+
+		check := func(op string, got safemath.Int128, err error, want *big.Int, wantErr error) {
+			t.Helper()
+			if wantErr == nil && (want.Cmp(minInt128) < 0 || want.Cmp(maxInt128) > 0) {
+				wantErr = safemath.ErrOverflow
+			}
+			if err != wantErr {
+				t.Fatalf("%v %s %v: error = %v; want %v", a, op, b, err, wantErr)
+			}
+			if err == nil && bigFull(got.Hi, got.Lo).Cmp(want) != 0 {
+				t.Fatalf("%v %s %v = %v; want %v", a, op, b, got, want)
+			}
+		}
+
+		got, err := a.Add(b)
+		check("+", got, err, new(big.Int).Add(ba, bb), nil)
+
+		got, err = a.Sub(b)
+		check("-", got, err, new(big.Int).Sub(ba, bb), nil)
+
+		got, err = a.Mul(b)
+		check("*", got, err, new(big.Int).Mul(ba, bb), nil)
+
+		if bb.Sign() == 0 {
+			_, err = a.Div(b)
+			check("/", safemath.Int128{}, err, nil, safemath.ErrDivisionByZero)
+			return
+		}
+
+		got, err = a.Div(b)
+		check("/", got, err, new(big.Int).Quo(ba, bb), nil)
+
+		var remErr error
+		if ba.Cmp(minInt128) == 0 && bb.Cmp(big.NewInt(-1)) == 0 {
+			remErr = safemath.ErrOverflow
+		}
+		got, err = a.Rem(b)
+		check("%", got, err, new(big.Int).Rem(ba, bb), remErr)
+
+		if s := a.String(); s != ba.String() {
+			t.Fatalf("String() = %q; want %q", s, ba.String())
+		}
+		if p, err := safemath.ParseInt128(ba.String()); err != nil || p != a {
+			t.Fatalf("ParseInt128(%q) = %v, %v", ba.String(), p, err)
+		}
+	})
+}
+
+// FuzzFromFloat verifies that FromFloat either fails or returns the rounded
+// value exactly.
+func FuzzFromFloat(f *testing.F) {
+	f.Add(float64(1.5), uint8(0))
+	f.Add(math.Ldexp(1, 63), uint8(3))
+	f.Add(math.NaN(), uint8(4))
+	f.Fuzz(func(t *testing.T, x float64, m uint8) {
+		mode := safemath.RoundingMode(m % 5)
+		got, err := safemath.FromFloat[int64](x, mode)
+		if err != nil {
+			if got != 0 {
+				t.Errorf("FromFloat(%v, %d) = %d on error %v", x, mode, got, err)
+			}
+			return
+		}
+
+		// Every int64 FromFloat accepts is within 1 of x and exactly
+		// representable after rounding.
+		if math.Abs(float64(got)-x) > 1 {
+			t.Errorf("FromFloat(%v, %d) = %d", x, mode, got)
+		}
+		checkConsistency(t, got, err, func() int64 { return safemath.MustFromFloat[int64](x, mode) })
+	})
+}
+
+// FuzzToFloat64 verifies that ToFloat64 succeeds exactly when the value
+// survives an exact round trip through math/big.
+func FuzzToFloat64(f *testing.F) {
+	f.Add(int64(1) << 53)
+	f.Add(int64(1)<<53 + 1)
+	f.Add(int64(math.MaxInt64))
+	f.Fuzz(func(t *testing.T, a int64) {
+		got, err := safemath.ToFloat64(a)
+		_, acc := new(big.Float).SetInt64(a).Float64()
+		if exact := acc == big.Exact; exact != (err == nil) {
+			t.Fatalf("ToFloat64(%d) = %v, %v; exact = %v", a, got, err, exact)
+		}
+		if err == nil && got != float64(a) {
+			t.Fatalf("ToFloat64(%d) = %v; want %v", a, got, float64(a))
+		}
+		checkConsistency(t, got, err, func() float64 { return safemath.MustToFloat64(a) })
+	})
+}
+
+// FuzzFromBigInt verifies that FromBigInt accepts exactly the 128-bit values
+// within the bounds of the target type, and round-trips through ToBigInt.
+func FuzzFromBigInt(f *testing.F) {
+	f.Add(int64(0), uint64(math.MaxUint64))
+	f.Add(int64(-1), uint64(1)<<63)
+	f.Add(int64(-1), uint64(math.MaxUint32))
+	f.Fuzz(func(t *testing.T, hi int64, lo uint64) {
+		x := bigFull(hi, lo)
+
+		got, err := safemath.FromBigInt[int64](x)
+		if fits := x.IsInt64(); fits != (err == nil) {
+			t.Fatalf("FromBigInt[int64](%v) = %d, %v; fits = %v", x, got, err, fits)
+		}
+		if err == nil && safemath.ToBigInt(got).Cmp(x) != 0 {
+			t.Fatalf("FromBigInt[int64](%v) = %d", x, got)
+		}
+		checkConsistency(t, got, err, func() int64 { return safemath.MustFromBigInt[int64](x) })
+
+		got32, err := safemath.FromBigInt[uint32](x)
+		if fits := x.Sign() >= 0 && x.BitLen() <= 32; fits != (err == nil) {
+			t.Fatalf("FromBigInt[uint32](%v) = %d, %v; fits = %v", x, got32, err, fits)
+		}
+		if err == nil && safemath.ToBigInt(got32).Cmp(x) != 0 {
+			t.Fatalf("FromBigInt[uint32](%v) = %d", x, got32)
+		}
+	})
+}
+
+// FuzzSumExact verifies SumExact against the exact total computed with
+// math/big.
+func FuzzSumExact(f *testing.F) {
+	f.Add(int64(math.MaxInt64), int64(1), int64(-1))
+	f.Add(int64(math.MinInt64), int64(math.MinInt64), int64(math.MaxInt64))
+	f.Fuzz(func(t *testing.T, a, b, c int64) {
+		xs := []int64{a, b, c}
+		want := new(big.Int)
+		for _, x := range xs {
+			want.Add(want, big.NewInt(x))
+		}
+
+		got, err := safemath.SumExact(xs)
+		if fits := want.IsInt64(); fits != (err == nil) {
+			t.Fatalf("SumExact(%v) = %d, %v; want %v", xs, got, err, want)
+		}
+		if err == nil && got != want.Int64() {
+			t.Fatalf("SumExact(%v) = %d; want %v", xs, got, want)
+		}
+		checkConsistency(t, got, err, func() int64 { return safemath.MustSumExact(xs) })
+
+		got8, err := safemath.SumExact([]int8{int8(a), int8(b), int8(c)})
+		want8 := int64(int8(a)) + int64(int8(b)) + int64(int8(c))
+		if fits := want8 >= math.MinInt8 && want8 <= math.MaxInt8; fits != (err == nil) || (fits && int64(got8) != want8) {
+			t.Fatalf("SumExact[int8](%d, %d, %d) = %d, %v; want %d", int8(a), int8(b), int8(c), got8, err, want8)
+		}
+	})
+}
+
+// bigQuoRound returns n/d rounded according to mode.
+func bigQuoRound(n, d *big.Int, mode safemath.RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	neg := n.Sign() != d.Sign()
+	twice := new(big.Int).Abs(r)
+	twice.Lsh(twice, 1)
+	cmp := twice.Cmp(new(big.Int).Abs(d))
+
+	var away bool
+	switch mode {
+	case safemath.RoundFloor:
+		away = neg
+	case safemath.RoundCeil:
+		away = !neg
+	case safemath.RoundHalfEven:
+		away = cmp > 0 || cmp == 0 && q.Bit(0) == 1
+	case safemath.RoundHalfAwayFromZero:
+		away = cmp >= 0
+	}
+	if away && neg {
+		q.Sub(q, big.NewInt(1))
+	} else if away {
+		q.Add(q, big.NewInt(1))
+	}
+
+	return q
+}
+
+// FuzzMulDiv verifies MulDiv against the exact quotient computed with
+// math/big.
+func FuzzMulDiv(f *testing.F) {
+	f.Add(int64(math.MaxInt64), int64(3), int64(4), uint8(0))
+	f.Add(int64(math.MinInt64), int64(-1), int64(1), uint8(1))
+	f.Add(int64(math.MinInt64), int64(3), int64(-2), uint8(3))
+	f.Fuzz(func(t *testing.T, a, b, c int64, m uint8) {
+		mode := safemath.RoundingMode(m % 5)
+		got, err := safemath.MulDiv(a, b, c, mode)
+		if c == 0 {
+			if !errors.Is(err, safemath.ErrDivisionByZero) {
+				t.Fatalf("MulDiv(%d, %d, 0) error = %v", a, b, err)
+			}
+			return
+		}
+
+		q := bigQuoRound(new(big.Int).Mul(big.NewInt(a), big.NewInt(b)), big.NewInt(c), mode)
+		if fits := q.IsInt64(); fits != (err == nil) || fits && got != q.Int64() {
+			t.Fatalf("MulDiv(%d, %d, %d, %d) = %d, %v; want %v", a, b, c, mode, got, err, q)
+		}
+		checkConsistency(t, got, err, func() int64 { return safemath.MustMulDiv(a, b, c, mode) })
+	})
+}
+
+// FuzzExtendedGCD verifies ExtendedGCD and ModInverse against math/big.
+func FuzzExtendedGCD(f *testing.F) {
+	f.Add(int64(math.MinInt64), int64(math.MaxInt64))
+	f.Add(int64(240), int64(-46))
+	f.Add(int64(-3), int64(math.MinInt64))
+	f.Fuzz(func(t *testing.T, a, b int64) {
+		ba, bb := big.NewInt(a), big.NewInt(b)
+		want := new(big.Int).GCD(nil, nil, new(big.Int).Abs(ba), new(big.Int).Abs(bb))
+
+		g, x, y, err := safemath.ExtendedGCD(a, b)
+		if !want.IsInt64() {
+			if err == nil {
+				t.Fatalf("ExtendedGCD(%d, %d) = %d; want overflow", a, b, g)
+			}
+		} else {
+			lhs := new(big.Int).Mul(ba, big.NewInt(x))
+			lhs.Add(lhs, new(big.Int).Mul(bb, big.NewInt(y)))
+			if err != nil || g != want.Int64() || lhs.Cmp(want) != 0 {
+				t.Fatalf("ExtendedGCD(%d, %d) = %d, %d, %d, %v; want gcd %v", a, b, g, x, y, err, want)
+			}
+		}
+
+		if b == 0 {
+			return
+		}
+		inv, err := safemath.ModInverse(a, b)
+		wantInv := new(big.Int).ModInverse(new(big.Int).Mod(ba, new(big.Int).Abs(bb)), new(big.Int).Abs(bb))
+		if wantInv == nil && (b == 1 || b == -1) {
+			wantInv = new(big.Int)
+		}
+		if wantInv == nil {
+			if !errors.Is(err, safemath.ErrNoInverse) {
+				t.Fatalf("ModInverse(%d, %d) = %d, %v; want ErrNoInverse", a, b, inv, err)
+			}
+		} else if err != nil || inv != wantInv.Int64() {
+			t.Fatalf("ModInverse(%d, %d) = %d, %v; want %v", a, b, inv, err, wantInv)
+		}
+	})
+}
+
+// FuzzIroot verifies Isqrt and Iroot against math/big.
+func FuzzIroot(f *testing.F) {
+	f.Add(uint64(math.MaxUint64), uint(2))
+	f.Add(uint64(math.MaxUint32*math.MaxUint32-1), uint(3))
+	f.Fuzz(func(t *testing.T, x uint64, n uint) {
+		bx := new(big.Int).SetUint64(x)
+		if got, _ := safemath.Isqrt(x); got != new(big.Int).Sqrt(bx).Uint64() {
+			t.Fatalf("Isqrt(%d) = %d; want %v", x, got, new(big.Int).Sqrt(bx))
+		}
+
+		n = n%70 + 1
+		r, err := safemath.Iroot(x, n)
+		if err != nil {
+			t.Fatalf("Iroot(%d, %d) error = %v", x, n, err)
+		}
+		// r^n <= x < (r+1)^n
+		bn := big.NewInt(int64(n))
+		br := new(big.Int).SetUint64(r)
+		lo := new(big.Int).Exp(br, bn, nil)
+		hi := new(big.Int).Exp(br.Add(br, big.NewInt(1)), bn, nil)
+		if lo.Cmp(bx) > 0 || hi.Cmp(bx) <= 0 {
+			t.Fatalf("Iroot(%d, %d) = %d", x, n, r)
+		}
+		checkConsistency(t, r, err, func() uint64 { return safemath.MustIroot(x, n) })
+	})
+}
+
+// FuzzParse cross-checks Parse against strconv.ParseInt.
+func FuzzParse(f *testing.F) {
+	f.Add("-9223372036854775808", 10)
+	f.Add("0x_7fff_ffff_ffff_ffff", 0)
+	f.Add("zz", 36)
+	f.Fuzz(func(t *testing.T, s string, base int) {
+		base = int(uint(base) % 37)
+		got, err := safemath.Parse[int64](s, base)
+		want, wantErr := strconv.ParseInt(s, base, 64)
+		if (err != nil) != (wantErr != nil) {
+			t.Fatalf("Parse(%q, %d) = %d, %v; strconv: %d, %v", s, base, got, err, want, wantErr)
+		}
+		if err == nil && got != want {
+			t.Fatalf("Parse(%q, %d) = %d; want %d", s, base, got, want)
+		}
+
+		gotBytes, errBytes := safemath.ParseBytes[int64]([]byte(s), base)
+		if gotBytes != got || (errBytes != nil) != (err != nil) {
+			t.Fatalf("ParseBytes(%q, %d) = %d, %v; Parse: %d, %v", s, base, gotBytes, errBytes, got, err)
+		}
+	})
+}
+
+// FuzzDecimal cross-checks Decimal arithmetic against math/big.
+func FuzzDecimal(f *testing.F) {
+	f.Add(int64(15), uint8(1), int64(25), uint8(2), uint8(1), uint8(3))
+	f.Add(int64(math.MinInt64), uint8(0), int64(-1), uint8(18), uint8(0), uint8(0))
+	f.Add(int64(math.MaxInt64), uint8(18), int64(3), uint8(0), uint8(18), uint8(4))
+	f.Fuzz(func(t *testing.T, ac int64, as uint8, bc int64, bs uint8, scale, m uint8) {
+		as, bs, scale = as%(safemath.MaxDecimalScale+1), bs%(safemath.MaxDecimalScale+1), scale%(safemath.MaxDecimalScale+1)
+		mode := safemath.RoundingMode(m % 5)
+
+		a, _ := safemath.NewDecimal(ac, as)
+		b, _ := safemath.NewDecimal(bc, bs)
+
+		pow10 := func(n uint8) *big.Int { return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil) }
+		scaled := func(c int64, n uint8) *big.Int { return new(big.Int).Mul(big.NewInt(c), pow10(n)) }
+
+		check := func(op string, got safemath.Decimal, err error, want *big.Int, wantScale uint8) {
+			t.Helper()
+			if fits := want.IsInt64(); fits != (err == nil) || !fits && !errors.Is(err, ovfDirection(want.Sign() < 0)) {
+				t.Fatalf("%v %s %v: error = %v; want %v", a, op, b, err, want)
+			}
+			if err == nil && (got.Coef() != want.Int64() || got.Scale() != wantScale) {
+				t.Fatalf("%v %s %v = %v; want coefficient %v at scale %d", a, op, b, got, want, wantScale)
+			}
+		}
+
+		s := as
+		if bs > s {
+			s = bs
+		}
+
+		got, err := a.Add(b)
+		check("+", got, err, new(big.Int).Add(scaled(ac, s-as), scaled(bc, s-bs)), s)
+
+		got, err = a.Sub(b)
+		check("-", got, err, new(big.Int).Sub(scaled(ac, s-as), scaled(bc, s-bs)), s)
+
+		got, err = a.Mul(b, scale, mode)
+		check("*", got, err, bigQuoRound(new(big.Int).Mul(scaled(ac, scale), big.NewInt(bc)), pow10(as+bs), mode), scale)
+
+		got, err = a.Rescale(scale, mode)
+		check("rescaled", got, err, bigQuoRound(scaled(ac, scale), pow10(as), mode), scale)
+
+		if want := new(big.Rat).SetFrac(big.NewInt(ac), pow10(as)).Cmp(new(big.Rat).SetFrac(big.NewInt(bc), pow10(bs))); a.Cmp(b) != want {
+			t.Fatalf("%v.Cmp(%v) = %d; want %d", a, b, a.Cmp(b), want)
+		}
+
+		if p, err := safemath.ParseDecimal(a.String()); err != nil || p != a {
+			t.Fatalf("ParseDecimal(%q) = %v, %v", a.String(), p, err)
+		}
+
+		if bc == 0 {
+			if _, err := a.Div(b, scale, mode); !errors.Is(err, safemath.ErrDivisionByZero) {
+				t.Fatalf("%v / %v: error = %v; want %v", a, b, err, safemath.ErrDivisionByZero)
+			}
+			return
+		}
+
+		got, err = a.Div(b, scale, mode)
+		check("/", got, err, bigQuoRound(scaled(ac, scale+bs), scaled(bc, as), mode), scale)
+	})
+}
@@ -1,6 +1,7 @@
 package safemath_test
 
 import (
+	"errors"
 	"math"
 	"testing"
 
@@ -79,7 +80,7 @@ func TestAdd(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := tt.fn(); err != tt.wantError {
+			if err := tt.fn(); !errors.Is(err, tt.wantError) {
 				t.Errorf("got error %v, want %v", err, tt.wantError)
 			}
 		})
@@ -136,7 +137,7 @@ func TestSub(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := tt.fn(); err != tt.wantError {
+			if err := tt.fn(); !errors.Is(err, tt.wantError) {
 				t.Errorf("got error %v, want %v", err, tt.wantError)
 			}
 		})
@@ -229,7 +230,7 @@ func TestMul(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := tt.fn(); err != tt.wantError {
+			if err := tt.fn(); !errors.Is(err, tt.wantError) {
 				t.Errorf("got error %v, want %v", err, tt.wantError)
 			}
 		})
@@ -270,7 +271,531 @@ func TestDiv(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := tt.fn(); err != tt.wantError {
+			if err := tt.fn(); !errors.Is(err, tt.wantError) {
+				t.Errorf("got error %v, want %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestRem(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() error
+		wantError error
+	}{
+		{
+			name: "divide by zero",
+			fn: func() error {
+				_, err := safemath.Rem(1, 0)
+				return err
+			},
+			wantError: safemath.ErrDivisionByZero,
+		},
+		{
+			name: "int8 min % -1",
+			fn: func() error {
+				_, err := safemath.Rem[int8](math.MinInt8, -1)
+				return err
+			},
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "int64 min % -1",
+			fn: func() error {
+				_, err := safemath.Rem[int64](math.MinInt64, -1)
+				return err
+			},
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "negative dividend ok",
+			fn: func() error {
+				v, err := safemath.Rem(-7, 3)
+				if err != nil {
+					return err
+				}
+				if v != -1 {
+					t.Errorf("want -1, got %d", v)
+				}
+				return nil
+			},
+			wantError: nil,
+		},
+		{
+			name: "uint8 ok",
+			fn: func() error {
+				v, err := safemath.Rem[uint8](math.MaxUint8, 10)
+				if err != nil {
+					return err
+				}
+				if v != 5 {
+					t.Errorf("want 5, got %d", v)
+				}
+				return nil
+			},
+			wantError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.fn(); !errors.Is(err, tt.wantError) {
+				t.Errorf("got error %v, want %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestNeg(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() error
+		wantError error
+	}{
+		{
+			name: "int8 min",
+			fn: func() error {
+				_, err := safemath.Neg[int8](math.MinInt8)
+				return err
+			},
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "int64 min",
+			fn: func() error {
+				_, err := safemath.Neg[int64](math.MinInt64)
+				return err
+			},
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "uint8 non-zero",
+			fn: func() error {
+				_, err := safemath.Neg[uint8](1)
+				return err
+			},
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "uint8 zero",
+			fn: func() error {
+				v, err := safemath.Neg[uint8](0)
+				if err != nil {
+					return err
+				}
+				if v != 0 {
+					t.Errorf("want 0, got %d", v)
+				}
+				return nil
+			},
+			wantError: nil,
+		},
+		{
+			name: "int8 max ok",
+			fn: func() error {
+				v, err := safemath.Neg[int8](math.MaxInt8)
+				if err != nil {
+					return err
+				}
+				if v != -math.MaxInt8 {
+					t.Errorf("want %d, got %d", -math.MaxInt8, v)
+				}
+				return nil
+			},
+			wantError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.fn(); !errors.Is(err, tt.wantError) {
+				t.Errorf("got error %v, want %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestAbs(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() error
+		wantError error
+	}{
+		{
+			name: "int8 min",
+			fn: func() error {
+				_, err := safemath.Abs[int8](math.MinInt8)
+				return err
+			},
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "int64 min",
+			fn: func() error {
+				_, err := safemath.Abs[int64](math.MinInt64)
+				return err
+			},
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "int8 negative ok",
+			fn: func() error {
+				v, err := safemath.Abs[int8](math.MinInt8 + 1)
+				if err != nil {
+					return err
+				}
+				if v != math.MaxInt8 {
+					t.Errorf("want %d, got %d", math.MaxInt8, v)
+				}
+				return nil
+			},
+			wantError: nil,
+		},
+		{
+			name: "uint64 max ok",
+			fn: func() error {
+				v, err := safemath.Abs[uint64](math.MaxUint64)
+				if err != nil {
+					return err
+				}
+				if v != math.MaxUint64 {
+					t.Errorf("want %d, got %d", uint64(math.MaxUint64), v)
+				}
+				return nil
+			},
+			wantError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.fn(); !errors.Is(err, tt.wantError) {
+				t.Errorf("got error %v, want %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestShl(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() error
+		wantError error
+	}{
+		{
+			name: "uint8 high bit lost",
+			fn: func() error {
+				_, err := safemath.Shl[uint8](0x81, 1)
+				return err
+			},
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "int8 sign flipped",
+			fn: func() error {
+				_, err := safemath.Shl[int8](64, 1)
+				return err
+			},
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "int8 negative sign flipped",
+			fn: func() error {
+				_, err := safemath.Shl[int8](-65, 1)
+				return err
+			},
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "uint64 shift >= bitsize",
+			fn: func() error {
+				_, err := safemath.Shl[uint64](1, 64)
+				return err
+			},
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "zero shift >= bitsize ok",
+			fn: func() error {
+				v, err := safemath.Shl[int32](0, 100)
+				if err != nil {
+					return err
+				}
+				if v != 0 {
+					t.Errorf("want 0, got %d", v)
+				}
+				return nil
+			},
+			wantError: nil,
+		},
+		{
+			name: "int8 min ok",
+			fn: func() error {
+				v, err := safemath.Shl[int8](-1, 7)
+				if err != nil {
+					return err
+				}
+				if v != math.MinInt8 {
+					t.Errorf("want %d, got %d", math.MinInt8, v)
+				}
+				return nil
+			},
+			wantError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.fn(); !errors.Is(err, tt.wantError) {
+				t.Errorf("got error %v, want %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestShr(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() error
+		wantError error
+	}{
+		{
+			name: "uint8 low bit lost",
+			fn: func() error {
+				_, err := safemath.Shr[uint8](3, 1)
+				return err
+			},
+			wantError: safemath.ErrTruncation,
+		},
+		{
+			name: "int8 negative low bit lost",
+			fn: func() error {
+				_, err := safemath.Shr[int8](-3, 1)
+				return err
+			},
+			wantError: safemath.ErrTruncation,
+		},
+		{
+			name: "int64 -1 shift >= bitsize",
+			fn: func() error {
+				_, err := safemath.Shr[int64](-1, 64)
+				return err
+			},
+			wantError: safemath.ErrTruncation,
+		},
+		{
+			name: "int8 negative ok",
+			fn: func() error {
+				v, err := safemath.Shr[int8](-128, 7)
+				if err != nil {
+					return err
+				}
+				if v != -1 {
+					t.Errorf("want -1, got %d", v)
+				}
+				return nil
+			},
+			wantError: nil,
+		},
+		{
+			name: "uint32 ok",
+			fn: func() error {
+				v, err := safemath.Shr[uint32](0xF0, 4)
+				if err != nil {
+					return err
+				}
+				if v != 0xF {
+					t.Errorf("want 15, got %d", v)
+				}
+				return nil
+			},
+			wantError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.fn(); !errors.Is(err, tt.wantError) {
+				t.Errorf("got error %v, want %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestShrTruncated(t *testing.T) {
+	tests := []struct {
+		name          string
+		fn            func() (int64, bool)
+		want          int64
+		wantTruncated bool
+	}{
+		{
+			name: "exact",
+			fn:   func() (int64, bool) { return safemath.ShrTruncated[int64](96, 5) },
+			want: 3,
+		},
+		{
+			name:          "low bit lost",
+			fn:            func() (int64, bool) { return safemath.ShrTruncated[int64](97, 5) },
+			want:          3,
+			wantTruncated: true,
+		},
+		{
+			name:          "negative rounds down",
+			fn:            func() (int64, bool) { return safemath.ShrTruncated[int64](-1, 1) },
+			want:          -1,
+			wantTruncated: true,
+		},
+		{
+			name:          "shift >= bitsize",
+			fn:            func() (int64, bool) { return safemath.ShrTruncated[int64](math.MaxInt64, 64) },
+			want:          0,
+			wantTruncated: true,
+		},
+		{
+			name:          "negative shift >= bitsize",
+			fn:            func() (int64, bool) { return safemath.ShrTruncated[int64](math.MinInt64, 200) },
+			want:          -1,
+			wantTruncated: true,
+		},
+		{
+			name: "zero shift >= bitsize",
+			fn:   func() (int64, bool) { return safemath.ShrTruncated[int64](0, 64) },
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, truncated := tt.fn()
+			if got != tt.want || truncated != tt.wantTruncated {
+				t.Errorf("got (%d, %v), want (%d, %v)", got, truncated, tt.want, tt.wantTruncated)
+			}
+		})
+	}
+}
+
+func TestPow(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func() error
+		wantError error
+	}{
+		{
+			name: "int8 overflow",
+			fn: func() error {
+				_, err := safemath.Pow[int8](2, 7)
+				return err
+			},
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "int8 negative base underflow",
+			fn: func() error {
+				_, err := safemath.Pow[int8](-3, 5)
+				return err
+			},
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "uint64 overflow",
+			fn: func() error {
+				_, err := safemath.Pow[uint64](10, 20)
+				return err
+			},
+			wantError: safemath.ErrOverflow,
+		},
+		{
+			name: "int8 min ok",
+			fn: func() error {
+				v, err := safemath.Pow[int8](-2, 7)
+				if err != nil {
+					return err
+				}
+				if v != math.MinInt8 {
+					t.Errorf("want %d, got %d", math.MinInt8, v)
+				}
+				return nil
+			},
+			wantError: nil,
+		},
+		{
+			name: "uint8 unused square ok",
+			fn: func() error {
+				// 16^2 fits, but squaring 16 again would overflow.
+				v, err := safemath.Pow[uint8](2, 7)
+				if err != nil {
+					return err
+				}
+				if v != 128 {
+					t.Errorf("want 128, got %d", v)
+				}
+				return nil
+			},
+			wantError: nil,
+		},
+		{
+			name: "uint64 ok",
+			fn: func() error {
+				v, err := safemath.Pow[uint64](10, 19)
+				if err != nil {
+					return err
+				}
+				if v != 1e19 {
+					t.Errorf("want 1e19, got %d", v)
+				}
+				return nil
+			},
+			wantError: nil,
+		},
+		{
+			name: "0^0",
+			fn: func() error {
+				v, err := safemath.Pow(0, 0)
+				if err != nil {
+					return err
+				}
+				if v != 1 {
+					t.Errorf("want 1, got %d", v)
+				}
+				return nil
+			},
+			wantError: nil,
+		},
+		{
+			name: "-1^odd",
+			fn: func() error {
+				v, err := safemath.Pow[int64](-1, math.MaxUint64)
+				if err != nil {
+					return err
+				}
+				if v != -1 {
+					t.Errorf("want -1, got %d", v)
+				}
+				return nil
+			},
+			wantError: nil,
+		},
+		{
+			name: "-1^even",
+			fn: func() error {
+				v, err := safemath.Pow[int64](-1, math.MaxUint64-1)
+				if err != nil {
+					return err
+				}
+				if v != 1 {
+					t.Errorf("want 1, got %d", v)
+				}
+				return nil
+			},
+			wantError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.fn(); !errors.Is(err, tt.wantError) {
 				t.Errorf("got error %v, want %v", err, tt.wantError)
 			}
 		})
@@ -347,13 +872,163 @@ func TestConvert(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := tt.fn(); err != tt.wantError {
+			if err := tt.fn(); !errors.Is(err, tt.wantError) {
 				t.Errorf("got error %v, want %v", err, tt.wantError)
 			}
 		})
 	}
 }
 
+func TestOpError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		sentinel error
+		op       string
+		typ      string
+		operands []any
+		msg      string
+	}{
+		{
+			name:     "Add",
+			err:      selectError(safemath.Add[int32](math.MaxInt32, 1)),
+			sentinel: safemath.ErrOverflow,
+			op:       "Add",
+			typ:      "int32",
+			operands: []any{int32(math.MaxInt32), int32(1)},
+			msg:      "Add[int32](2147483647, 1): integer overflow",
+		},
+		{
+			name:     "Div",
+			err:      selectError(safemath.Div[uint8](1, 0)),
+			sentinel: safemath.ErrDivisionByZero,
+			op:       "Div",
+			typ:      "uint8",
+			operands: []any{uint8(1), uint8(0)},
+			msg:      "Div[uint8](1, 0): division by zero",
+		},
+		{
+			name:     "Shl",
+			err:      selectError(safemath.Shl[int8](1, 7)),
+			sentinel: safemath.ErrOverflow,
+			op:       "Shl",
+			typ:      "int8",
+			operands: []any{int8(1), uint(7)},
+			msg:      "Shl[int8](1, 7): integer overflow",
+		},
+		{
+			name:     "Convert",
+			err:      selectError(safemath.Convert[uint16](int64(-1))),
+			sentinel: safemath.ErrTruncation,
+			op:       "Convert",
+			typ:      "uint16",
+			operands: []any{int64(-1)},
+			msg:      "Convert[uint16](-1): integer type truncation (integer underflow)",
+		},
+		{
+			name:     "ConvertAny",
+			err:      selectError(safemath.ConvertAny[byte](1.5)),
+			sentinel: safemath.ErrInvalidType,
+			op:       "ConvertAny",
+			typ:      "uint8",
+			operands: []any{1.5},
+			msg:      "ConvertAny[uint8](1.5): invalid integer type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !errors.Is(tt.err, tt.sentinel) {
+				t.Errorf("errors.Is(%v, %v) = false", tt.err, tt.sentinel)
+			}
+
+			var opErr *safemath.OpError
+			if !errors.As(tt.err, &opErr) {
+				t.Fatalf("got error %T, want *safemath.OpError", tt.err)
+			}
+			if opErr.Op != tt.op || opErr.Type != tt.typ {
+				t.Errorf("got Op %q, Type %q; want %q, %q", opErr.Op, opErr.Type, tt.op, tt.typ)
+			}
+			if len(opErr.Operands) != len(tt.operands) {
+				t.Fatalf("got %d operands, want %d", len(opErr.Operands), len(tt.operands))
+			}
+			for i, v := range tt.operands {
+				if opErr.Operands[i] != v {
+					t.Errorf("operand %d = %v (%T), want %v (%T)", i, opErr.Operands[i], opErr.Operands[i], v, v)
+				}
+			}
+			if opErr.Error() != tt.msg {
+				t.Errorf("got message %q, want %q", opErr.Error(), tt.msg)
+			}
+		})
+	}
+}
+
+func TestOverflowDirection(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		category  error
+		direction error
+	}{
+		{name: "Add int8 positive", err: selectError(safemath.Add[int8](math.MaxInt8, 1)), category: safemath.ErrOverflow, direction: safemath.ErrPositiveOverflow},
+		{name: "Add int8 negative", err: selectError(safemath.Add[int8](math.MinInt8, -1)), category: safemath.ErrOverflow, direction: safemath.ErrNegativeOverflow},
+		{name: "Add uint8 positive", err: selectError(safemath.Add[uint8](math.MaxUint8, 1)), category: safemath.ErrOverflow, direction: safemath.ErrPositiveOverflow},
+		{name: "Sub int8 positive", err: selectError(safemath.Sub[int8](math.MaxInt8, -1)), category: safemath.ErrOverflow, direction: safemath.ErrPositiveOverflow},
+		{name: "Sub int8 negative", err: selectError(safemath.Sub[int8](math.MinInt8, 1)), category: safemath.ErrOverflow, direction: safemath.ErrNegativeOverflow},
+		{name: "Sub uint8 negative", err: selectError(safemath.Sub[uint8](0, 1)), category: safemath.ErrOverflow, direction: safemath.ErrNegativeOverflow},
+		{name: "Mul int8 positive", err: selectError(safemath.Mul[int8](math.MinInt8, -1)), category: safemath.ErrOverflow, direction: safemath.ErrPositiveOverflow},
+		{name: "Mul int8 negative", err: selectError(safemath.Mul[int8](math.MaxInt8, -2)), category: safemath.ErrOverflow, direction: safemath.ErrNegativeOverflow},
+		{name: "Mul uint64 positive", err: selectError(safemath.Mul[uint64](math.MaxUint64, 2)), category: safemath.ErrOverflow, direction: safemath.ErrPositiveOverflow},
+		{name: "Div int64 positive", err: selectError(safemath.Div[int64](math.MinInt64, -1)), category: safemath.ErrOverflow, direction: safemath.ErrPositiveOverflow},
+		{name: "Convert int to uint negative", err: selectError(safemath.Convert[uint](-1)), category: safemath.ErrTruncation, direction: safemath.ErrNegativeOverflow},
+		{name: "Convert int to int8 negative", err: selectError(safemath.Convert[int8](-129)), category: safemath.ErrTruncation, direction: safemath.ErrNegativeOverflow},
+		{name: "Convert uint64 to int64 positive", err: selectError(safemath.Convert[int64](uint64(math.MaxUint64))), category: safemath.ErrTruncation, direction: safemath.ErrPositiveOverflow},
+		{name: "Rem int8 positive", err: selectError(safemath.Rem[int8](math.MinInt8, -1)), category: safemath.ErrOverflow, direction: safemath.ErrPositiveOverflow},
+		{name: "RemEuclid int8 positive", err: selectError(safemath.RemEuclid[int8](math.MinInt8, -1)), category: safemath.ErrOverflow, direction: safemath.ErrPositiveOverflow},
+		{name: "Mod int8 positive", err: selectError(safemath.Mod[int8](math.MinInt8, -1)), category: safemath.ErrOverflow, direction: safemath.ErrPositiveOverflow},
+		{name: "ExtendedGCD int8 positive", err: extendedGCDError[int8](math.MinInt8, 0), category: safemath.ErrOverflow, direction: safemath.ErrPositiveOverflow},
+		{name: "ConvertInt128 to int64 negative", err: selectError(safemath.ConvertInt128[int64](safemath.Int128{Hi: -2})), category: safemath.ErrTruncation, direction: safemath.ErrNegativeOverflow},
+		{name: "FromFloat to uint8 positive", err: selectError(safemath.FromFloat[uint8](256.0, safemath.RoundTruncate)), category: safemath.ErrTruncation, direction: safemath.ErrPositiveOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !errors.Is(tt.err, tt.category) {
+				t.Errorf("errors.Is(%v, %v) = false", tt.err, tt.category)
+			}
+			if !errors.Is(tt.err, tt.direction) {
+				t.Errorf("errors.Is(%v, %v) = false", tt.err, tt.direction)
+			}
+
+			opposite := safemath.ErrPositiveOverflow
+			if tt.direction == safemath.ErrPositiveOverflow {
+				opposite = safemath.ErrNegativeOverflow
+			}
+			if errors.Is(tt.err, opposite) {
+				t.Errorf("errors.Is(%v, %v) = true", tt.err, opposite)
+			}
+		})
+	}
+
+	// Division by zero and precision loss within the bounds have no direction.
+	for _, err := range []error{
+		selectError(safemath.Div(1, 0)),
+		selectError(safemath.Shr[int8](3, 1)),
+		selectError(safemath.ToFloat64[int64](1<<53 + 1)),
+	} {
+		if err == nil || errors.Is(err, safemath.ErrPositiveOverflow) || errors.Is(err, safemath.ErrNegativeOverflow) {
+			t.Errorf("got error %v, want one without a direction", err)
+		}
+	}
+}
+
+func extendedGCDError[T safemath.Signed](a, b T) error {
+	_, _, _, err := safemath.ExtendedGCD(a, b)
+
+	return err
+}
+
 func TestMulPanicSafety(t *testing.T) {
 	// Ensure that multiplying -1 by MinInt doesn't panic.
 	// This specifically validates the fix for the "Mul Division Hazard" where
@@ -367,7 +1042,7 @@ func TestMulPanicSafety(t *testing.T) {
 			}
 		}()
 		err := fn()
-		if err != safemath.ErrOverflow {
+		if !errors.Is(err, safemath.ErrOverflow) {
 			t.Errorf("%s: want ErrOverflow, got %v", name, err)
 		}
 	}
@@ -448,6 +1123,102 @@ func TestMust(t *testing.T) {
 		safemath.MustDiv(1, 0)
 	})
 
+	t.Run("Rem", func(t *testing.T) {
+		// Success case
+		if got := safemath.MustRem(7, 3); got != 1 {
+			t.Errorf("MustRem(7, 3) = %d; want 1", got)
+		}
+		// Panic case
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("MustRem did not panic on zero division")
+			} else {
+				t.Logf("Recovered from: %v", r)
+			}
+		}()
+		safemath.MustRem(1, 0)
+	})
+
+	t.Run("Neg", func(t *testing.T) {
+		// Success case
+		if got := safemath.MustNeg(5); got != -5 {
+			t.Errorf("MustNeg(5) = %d; want -5", got)
+		}
+		// Panic case
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("MustNeg did not panic on overflow")
+			} else {
+				t.Logf("Recovered from: %v", r)
+			}
+		}()
+		safemath.MustNeg[int8](math.MinInt8)
+	})
+
+	t.Run("Abs", func(t *testing.T) {
+		// Success case
+		if got := safemath.MustAbs(-5); got != 5 {
+			t.Errorf("MustAbs(-5) = %d; want 5", got)
+		}
+		// Panic case
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("MustAbs did not panic on overflow")
+			} else {
+				t.Logf("Recovered from: %v", r)
+			}
+		}()
+		safemath.MustAbs[int8](math.MinInt8)
+	})
+
+	t.Run("Shl", func(t *testing.T) {
+		// Success case
+		if got := safemath.MustShl(3, 2); got != 12 {
+			t.Errorf("MustShl(3, 2) = %d; want 12", got)
+		}
+		// Panic case
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("MustShl did not panic on overflow")
+			} else {
+				t.Logf("Recovered from: %v", r)
+			}
+		}()
+		safemath.MustShl[int8](1, 7)
+	})
+
+	t.Run("Shr", func(t *testing.T) {
+		// Success case
+		if got := safemath.MustShr(12, 2); got != 3 {
+			t.Errorf("MustShr(12, 2) = %d; want 3", got)
+		}
+		// Panic case
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("MustShr did not panic on truncation")
+			} else {
+				t.Logf("Recovered from: %v", r)
+			}
+		}()
+		safemath.MustShr(13, 2)
+	})
+
+	t.Run("Pow", func(t *testing.T) {
+		// Success case
+		if got := safemath.MustPow(3, 4); got != 81 {
+			t.Errorf("MustPow(3, 4) = %d; want 81", got)
+		}
+		// Panic case
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("MustPow did not panic on overflow")
+			} else {
+				t.Logf("Recovered from: %v", r)
+			}
+		}()
+		safemath.MustPow[int8](2, 7)
+	})
+
 	t.Run("Convert", func(t *testing.T) {
 		// Success case
 		if got := safemath.MustConvert[uint](10); got != 10 {
@@ -482,7 +1253,7 @@ func TestConvertAny(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			got, err := safemath.ConvertAny[uint8](tt.val)
-			if err != tt.err {
+			if !errors.Is(err, tt.err) {
 				t.Fatalf("error = %v, want %v", err, tt.err)
 			}
 			if tt.err == nil && got != tt.want {
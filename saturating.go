@@ -0,0 +1,190 @@
+package safemath
+
+// SaturatingAdd returns the sum of a and b, clamped to the bounds of T if
+// overflow occurs.
+func SaturatingAdd[T Integer](a, b T) T {
+	c, overflow := OverflowingAdd(a, b)
+	if overflow {
+		// Overflow can only happen in the direction of b.
+		if isSigned[T]() && b < 0 {
+			return minOf[T]()
+		}
+
+		return maxOf[T]()
+	}
+
+	return c
+}
+
+// SaturatingSub returns the difference of a and b, clamped to the bounds of
+// T if overflow occurs.
+func SaturatingSub[T Integer](a, b T) T {
+	c, overflow := OverflowingSub(a, b)
+	if overflow {
+		// Overflow can only happen in the opposite direction of b. Unsigned
+		// subtraction can only underflow.
+		if isSigned[T]() && b < 0 {
+			return maxOf[T]()
+		}
+
+		return minOf[T]()
+	}
+
+	return c
+}
+
+// SaturatingMul returns the product of a and b, clamped to the bounds of T
+// if overflow occurs.
+func SaturatingMul[T Integer](a, b T) T {
+	c, overflow := OverflowingMul(a, b)
+	if overflow {
+		// The exact product is negative only if exactly one operand is.
+		if (a < 0) != (b < 0) {
+			return minOf[T]()
+		}
+
+		return maxOf[T]()
+	}
+
+	return c
+}
+
+// SaturatingDiv returns the quotient of a and b, clamped to the bounds of T
+// if overflow occurs (i.e., MinInt / -1 returns MaxInt).
+//
+// Division by zero has no meaningful bound, so SaturatingDiv panics with an
+// error wrapping ErrDivisionByZero when b is zero.
+func SaturatingDiv[T Integer](a, b T) T {
+	c, err := Div(a, b)
+	if err != nil {
+		if b == 0 {
+			panic(err)
+		}
+
+		return maxOf[T]()
+	}
+
+	return c
+}
+
+// SaturatingNeg returns the negation of a, clamped to the bounds of T if
+// overflow occurs (i.e., -MinInt returns MaxInt, and any unsigned value
+// returns 0).
+func SaturatingNeg[T Integer](a T) T {
+	c, err := Neg(a)
+	if err != nil {
+		if isSigned[T]() {
+			return maxOf[T]()
+		}
+
+		return 0
+	}
+
+	return c
+}
+
+// SaturatingAbs returns the absolute value of a, clamped to the bounds of T
+// if overflow occurs (i.e., |MinInt| returns MaxInt).
+func SaturatingAbs[T Integer](a T) T {
+	c, err := Abs(a)
+	if err != nil {
+		return maxOf[T]()
+	}
+
+	return c
+}
+
+// SaturatingShl returns v shifted left by n bits, clamped to the bounds of T
+// if any set bit would be shifted out.
+func SaturatingShl[T Integer](v T, n uint) T {
+	c, err := Shl(v, n)
+	if err != nil {
+		if v < 0 {
+			return minOf[T]()
+		}
+
+		return maxOf[T]()
+	}
+
+	return c
+}
+
+// SaturatingPow returns base raised to the power of exp, clamped to the
+// bounds of T if overflow occurs.
+func SaturatingPow[T Integer](base T, exp uint) T {
+	c, err := Pow(base, exp)
+	if err != nil {
+		// The exact power is negative only for a negative base and an odd
+		// exponent.
+		if base < 0 && exp&1 == 1 {
+			return minOf[T]()
+		}
+
+		return maxOf[T]()
+	}
+
+	return c
+}
+
+// SaturatingConvert converts a value from one Integer type to another,
+// clamped to the bounds of To if v cannot be represented. It is equivalent to
+// [ConvertClamp].
+func SaturatingConvert[To, From Integer](v From) To {
+	return ConvertClamp[To](v)
+}
+
+// ConvertClamp converts a value from one Integer type to another, returning
+// the nearest value representable by To: negative values that do not fit
+// clamp to the minimum of To (0 for unsigned types), and positive ones to its
+// maximum.
+func ConvertClamp[To, From Integer](v From) To {
+	c, _ := ConvertClamped[To](v)
+
+	return c
+}
+
+// ConvertClamped is like [ConvertClamp], but also reports whether v had to be
+// clamped.
+func ConvertClamped[To, From Integer](v From) (c To, clamped bool) {
+	c, ok := convert[To](v)
+	switch {
+	case ok:
+		return c, false
+	case v < 0:
+		return minOf[To](), true
+	default:
+		return maxOf[To](), true
+	}
+}
+
+// ConvertAnyClamp converts v (any integer type) into To like [ConvertClamp].
+//
+// Returns ErrInvalidType when v is not an integer.
+func ConvertAnyClamp[To Integer](v any) (To, error) {
+	switch x := v.(type) {
+	case int:
+		return ConvertClamp[To](x), nil
+	case int8:
+		return ConvertClamp[To](x), nil
+	case int16:
+		return ConvertClamp[To](x), nil
+	case int32:
+		return ConvertClamp[To](x), nil
+	case int64:
+		return ConvertClamp[To](x), nil
+	case uint:
+		return ConvertClamp[To](x), nil
+	case uint8:
+		return ConvertClamp[To](x), nil
+	case uint16:
+		return ConvertClamp[To](x), nil
+	case uint32:
+		return ConvertClamp[To](x), nil
+	case uint64:
+		return ConvertClamp[To](x), nil
+	case uintptr:
+		return ConvertClamp[To](x), nil
+	default:
+		return 0, unaryOpError[To]("ConvertAnyClamp", ErrInvalidType, nil, v)
+	}
+}
@@ -0,0 +1,160 @@
+package safemath_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"go.dw1.io/safemath"
+)
+
+func TestSaturating(t *testing.T) {
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		// Add
+		{name: "Add int8 overflow", got: safemath.SaturatingAdd[int8](math.MaxInt8, 1), want: int8(math.MaxInt8)},
+		{name: "Add int8 underflow", got: safemath.SaturatingAdd[int8](math.MinInt8, -1), want: int8(math.MinInt8)},
+		{name: "Add uint8 overflow", got: safemath.SaturatingAdd[uint8](math.MaxUint8, 1), want: uint8(math.MaxUint8)},
+		{name: "Add int64 ok", got: safemath.SaturatingAdd[int64](-5, 3), want: int64(-2)},
+
+		// Sub
+		{name: "Sub int8 underflow", got: safemath.SaturatingSub[int8](math.MinInt8, 1), want: int8(math.MinInt8)},
+		{name: "Sub int8 overflow", got: safemath.SaturatingSub[int8](math.MaxInt8, -1), want: int8(math.MaxInt8)},
+		{name: "Sub uint64 underflow", got: safemath.SaturatingSub[uint64](0, 1), want: uint64(0)},
+		{name: "Sub int64 ok", got: safemath.SaturatingSub[int64](3, 5), want: int64(-2)},
+
+		// Mul
+		{name: "Mul int8 overflow", got: safemath.SaturatingMul[int8](math.MaxInt8, 2), want: int8(math.MaxInt8)},
+		{name: "Mul int8 underflow", got: safemath.SaturatingMul[int8](math.MaxInt8, -2), want: int8(math.MinInt8)},
+		{name: "Mul int8 negatives", got: safemath.SaturatingMul[int8](math.MinInt8, -1), want: int8(math.MaxInt8)},
+		{name: "Mul uint32 overflow", got: safemath.SaturatingMul[uint32](math.MaxUint32, 2), want: uint32(math.MaxUint32)},
+		{name: "Mul int ok", got: safemath.SaturatingMul(-3, 4), want: -12},
+
+		// Div
+		{name: "Div int8 min / -1", got: safemath.SaturatingDiv[int8](math.MinInt8, -1), want: int8(math.MaxInt8)},
+		{name: "Div int64 ok", got: safemath.SaturatingDiv[int64](-9, 2), want: int64(-4)},
+
+		// Neg
+		{name: "Neg int8 min", got: safemath.SaturatingNeg[int8](math.MinInt8), want: int8(math.MaxInt8)},
+		{name: "Neg uint8", got: safemath.SaturatingNeg[uint8](5), want: uint8(0)},
+		{name: "Neg int ok", got: safemath.SaturatingNeg(5), want: -5},
+
+		// Abs
+		{name: "Abs int64 min", got: safemath.SaturatingAbs[int64](math.MinInt64), want: int64(math.MaxInt64)},
+		{name: "Abs int ok", got: safemath.SaturatingAbs(-5), want: 5},
+
+		// Shl
+		{name: "Shl int8 overflow", got: safemath.SaturatingShl[int8](3, 6), want: int8(math.MaxInt8)},
+		{name: "Shl int8 underflow", got: safemath.SaturatingShl[int8](-3, 6), want: int8(math.MinInt8)},
+		{name: "Shl uint16 overflow", got: safemath.SaturatingShl[uint16](1, 16), want: uint16(math.MaxUint16)},
+		{name: "Shl uint16 ok", got: safemath.SaturatingShl[uint16](1, 15), want: uint16(1 << 15)},
+
+		// Pow
+		{name: "Pow int8 overflow", got: safemath.SaturatingPow[int8](3, 5), want: int8(math.MaxInt8)},
+		{name: "Pow int8 underflow", got: safemath.SaturatingPow[int8](-3, 5), want: int8(math.MinInt8)},
+		{name: "Pow int8 even negative", got: safemath.SaturatingPow[int8](-3, 6), want: int8(math.MaxInt8)},
+		{name: "Pow uint8 ok", got: safemath.SaturatingPow[uint8](3, 5), want: uint8(243)},
+
+		// Convert
+		{name: "Convert int to uint8 negative", got: safemath.SaturatingConvert[uint8](-1), want: uint8(0)},
+		{name: "Convert int to int8 overflow", got: safemath.SaturatingConvert[int8](1000), want: int8(math.MaxInt8)},
+		{name: "Convert int to int8 underflow", got: safemath.SaturatingConvert[int8](-1000), want: int8(math.MinInt8)},
+		{name: "Convert uint64 to int64 overflow", got: safemath.SaturatingConvert[int64](uint64(math.MaxUint64)), want: int64(math.MaxInt64)},
+		{name: "Convert int64 to uint16 ok", got: safemath.SaturatingConvert[uint16](int64(65535)), want: uint16(65535)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v (%T), want %v (%T)", tt.got, tt.got, tt.want, tt.want)
+			}
+		})
+	}
+}
+
+func TestSaturatingDivPanicsOnZero(t *testing.T) {
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, safemath.ErrDivisionByZero) {
+			t.Errorf("recovered %v, want %v", err, safemath.ErrDivisionByZero)
+		}
+	}()
+	safemath.SaturatingDiv(1, 0)
+}
+
+func TestConvertClampExhaustive16(t *testing.T) {
+	clamp := func(v, lo, hi int) int {
+		if v < lo {
+			return lo
+		}
+		if v > hi {
+			return hi
+		}
+
+		return v
+	}
+
+	for v := math.MinInt16; v <= math.MaxInt16; v++ {
+		want := clamp(v, math.MinInt8, math.MaxInt8)
+		got, clamped := safemath.ConvertClamped[int8](int16(v))
+		if int(got) != want || clamped != (want != v) {
+			t.Fatalf("ConvertClamped[int8](%d) = %d, %v; want %d", v, got, clamped, want)
+		}
+
+		want = clamp(v, 0, math.MaxUint8)
+		if got := safemath.ConvertClamp[uint8](int16(v)); int(got) != want {
+			t.Fatalf("ConvertClamp[uint8](%d) = %d; want %d", v, got, want)
+		}
+	}
+
+	for v := 0; v <= math.MaxUint16; v++ {
+		want := clamp(v, math.MinInt8, math.MaxInt8)
+		got, clamped := safemath.ConvertClamped[int8](uint16(v))
+		if int(got) != want || clamped != (want != v) {
+			t.Fatalf("ConvertClamped[int8](uint16(%d)) = %d, %v; want %d", v, got, clamped, want)
+		}
+	}
+}
+
+func TestConvertClamp(t *testing.T) {
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{name: "uint64 max to int64", got: safemath.ConvertClamp[int64](uint64(math.MaxUint64)), want: int64(math.MaxInt64)},
+		{name: "int64 min to uint64", got: safemath.ConvertClamp[uint64](int64(math.MinInt64)), want: uint64(0)},
+		{name: "int64 max to uint64", got: safemath.ConvertClamp[uint64](int64(math.MaxInt64)), want: uint64(math.MaxInt64)},
+		{name: "int64 min to int32", got: safemath.ConvertClamp[int32](int64(math.MinInt64)), want: int32(math.MinInt32)},
+		{name: "uint8 to int8", got: safemath.ConvertClamp[int8](uint8(200)), want: int8(math.MaxInt8)},
+		{name: "uintptr to uint16", got: safemath.ConvertClamp[uint16](uintptr(1 << 20)), want: uint16(math.MaxUint16)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v (%T), want %v (%T)", tt.got, tt.got, tt.want, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertAnyClamp(t *testing.T) {
+	for _, v := range []any{int(-1), int8(-1), int16(-1), int32(-1), int64(-1)} {
+		if got, err := safemath.ConvertAnyClamp[uint8](v); err != nil || got != 0 {
+			t.Errorf("ConvertAnyClamp[uint8](%T(-1)) = %d, %v; want 0", v, got, err)
+		}
+	}
+
+	for _, v := range []any{uint(300), uint8(255), uint16(300), uint32(300), uint64(300), uintptr(300)} {
+		if got, err := safemath.ConvertAnyClamp[uint8](v); err != nil || got != math.MaxUint8 {
+			t.Errorf("ConvertAnyClamp[uint8](%T(%v)) = %d, %v; want 255", v, v, got, err)
+		}
+	}
+
+	if _, err := safemath.ConvertAnyClamp[uint8]("300"); !errors.Is(err, safemath.ErrInvalidType) {
+		t.Errorf("ConvertAnyClamp[uint8](\"300\") error = %v; want %v", err, safemath.ErrInvalidType)
+	}
+}